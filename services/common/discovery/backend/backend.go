@@ -0,0 +1,93 @@
+// Package backend creates the discovery.Registry a service is configured to
+// use. It lives apart from package discovery because every backend imports
+// that package.
+package backend
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/daffaromero/retries/services/common/discovery"
+	"github.com/daffaromero/retries/services/common/discovery/consul"
+	"github.com/daffaromero/retries/services/common/discovery/dns"
+	"github.com/daffaromero/retries/services/common/discovery/file"
+	"github.com/daffaromero/retries/services/common/discovery/memory"
+	"github.com/daffaromero/retries/services/common/discovery/remote"
+	"github.com/daffaromero/retries/services/common/utils"
+)
+
+const (
+	Consul = "consul"
+	File   = "file"
+	DNS    = "dns"
+	Memory = "memory"
+	Remote = "remote"
+)
+
+type Config struct {
+	// Backend is one of Consul (the default), File, DNS, Memory or Remote.
+	Backend    string
+	ConsulAddr string
+	// RegistryAddr is the registry server the Remote backend talks to.
+	RegistryAddr string
+	// File is the YAML or JSON file the File backend reads.
+	File string
+	// DNSDomain is appended to service names by the DNS backend.
+	DNSDomain string
+	// DNSPort is the port of instances found through A records.
+	DNSPort int
+}
+
+// ConfigFromEnv reads DISCOVERY_BACKEND, CONSUL_ADDR, REGISTRY_ADDR,
+// DISCOVERY_FILE, DISCOVERY_DNS_DOMAIN and DISCOVERY_DNS_PORT.
+func ConfigFromEnv() (Config, error) {
+	cfg := Config{
+		Backend:      utils.GetEnv("DISCOVERY_BACKEND"),
+		ConsulAddr:   utils.GetEnv("CONSUL_ADDR"),
+		RegistryAddr: utils.GetEnv("REGISTRY_ADDR"),
+		File:         utils.GetEnv("DISCOVERY_FILE"),
+		DNSDomain:    utils.GetEnv("DISCOVERY_DNS_DOMAIN"),
+	}
+	if port := utils.GetEnv("DISCOVERY_DNS_PORT"); port != "" {
+		p, err := strconv.Atoi(port)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid DISCOVERY_DNS_PORT %q: %w", port, err)
+		}
+		cfg.DNSPort = p
+	}
+	return cfg, nil
+}
+
+// New creates the registry cfg selects.
+func New(cfg Config) (discovery.Registry, error) {
+	switch cfg.Backend {
+	case "", Consul:
+		return consul.NewRegistry(cfg.ConsulAddr, "")
+	case File:
+		if cfg.File == "" {
+			return nil, fmt.Errorf("the %s discovery backend needs a file", File)
+		}
+		return file.NewRegistry(cfg.File)
+	case DNS:
+		return dns.NewRegistry(net.DefaultResolver, cfg.DNSDomain, cfg.DNSPort), nil
+	case Memory:
+		return memory.NewRegistry(), nil
+	case Remote:
+		if cfg.RegistryAddr == "" {
+			return nil, fmt.Errorf("the %s discovery backend needs a registry address", Remote)
+		}
+		return remote.NewRegistry(cfg.RegistryAddr), nil
+	default:
+		return nil, fmt.Errorf("unknown discovery backend %q", cfg.Backend)
+	}
+}
+
+// NewFromEnv creates the registry the environment selects.
+func NewFromEnv() (discovery.Registry, error) {
+	cfg, err := ConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	return New(cfg)
+}
@@ -0,0 +1,146 @@
+package discovery
+
+import (
+	"math/rand"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/balancer/base"
+)
+
+// Load balancing policies for connections made by ConnectToService.
+const (
+	RoundRobin   = "registry_round_robin"
+	LeastRequest = "registry_least_request"
+	Weighted     = "registry_weighted"
+)
+
+func init() {
+	balancer.Register(base.NewBalancerBuilder(RoundRobin, roundRobinBuilder{}, base.Config{HealthCheck: true}))
+	balancer.Register(base.NewBalancerBuilder(LeastRequest, leastRequestBuilder{}, base.Config{HealthCheck: true}))
+	balancer.Register(base.NewBalancerBuilder(Weighted, weightedBuilder{}, base.Config{HealthCheck: true}))
+}
+
+// balancerName maps the policy names used in config onto registered
+// balancers. Unknown names fall back to round robin.
+func balancerName(policy string) string {
+	switch policy {
+	case "least_request":
+		return LeastRequest
+	case "weighted":
+		return Weighted
+	default:
+		return RoundRobin
+	}
+}
+
+type roundRobinBuilder struct{}
+
+func (roundRobinBuilder) Build(info base.PickerBuildInfo) balancer.Picker {
+	if len(info.ReadySCs) == 0 {
+		return base.NewErrPicker(balancer.ErrNoSubConnAvailable)
+	}
+	scs := make([]balancer.SubConn, 0, len(info.ReadySCs))
+	for sc := range info.ReadySCs {
+		scs = append(scs, sc)
+	}
+	return &roundRobinPicker{scs: scs, next: uint32(rand.Intn(len(scs)))}
+}
+
+type roundRobinPicker struct {
+	scs  []balancer.SubConn
+	next uint32
+}
+
+func (p *roundRobinPicker) Pick(balancer.PickInfo) (balancer.PickResult, error) {
+	n := atomic.AddUint32(&p.next, 1)
+	return balancer.PickResult{SubConn: p.scs[n%uint32(len(p.scs))]}, nil
+}
+
+type leastRequestBuilder struct{}
+
+func (leastRequestBuilder) Build(info base.PickerBuildInfo) balancer.Picker {
+	if len(info.ReadySCs) == 0 {
+		return base.NewErrPicker(balancer.ErrNoSubConnAvailable)
+	}
+	p := &leastRequestPicker{}
+	for sc := range info.ReadySCs {
+		p.conns = append(p.conns, &leastRequestConn{sc: sc})
+	}
+	return p
+}
+
+type leastRequestConn struct {
+	sc       balancer.SubConn
+	inFlight atomic.Int64
+}
+
+// leastRequestPicker sends each call to the instance with the fewest calls in
+// flight, breaking ties at random so idle instances share the load.
+type leastRequestPicker struct {
+	conns []*leastRequestConn
+}
+
+func (p *leastRequestPicker) Pick(balancer.PickInfo) (balancer.PickResult, error) {
+	start := rand.Intn(len(p.conns))
+	best := p.conns[start]
+	for i := 1; i < len(p.conns); i++ {
+		c := p.conns[(start+i)%len(p.conns)]
+		if c.inFlight.Load() < best.inFlight.Load() {
+			best = c
+		}
+	}
+	best.inFlight.Add(1)
+	return balancer.PickResult{
+		SubConn: best.sc,
+		Done: func(balancer.DoneInfo) {
+			best.inFlight.Add(-1)
+		},
+	}, nil
+}
+
+type weightedBuilder struct{}
+
+func (weightedBuilder) Build(info base.PickerBuildInfo) balancer.Picker {
+	if len(info.ReadySCs) == 0 {
+		return base.NewErrPicker(balancer.ErrNoSubConnAvailable)
+	}
+	p := &weightedPicker{}
+	for sc, sci := range info.ReadySCs {
+		w := Weight(sci.Address)
+		p.conns = append(p.conns, &weightedConn{sc: sc, weight: w})
+		p.total += w
+	}
+	return p
+}
+
+type weightedConn struct {
+	sc      balancer.SubConn
+	weight  int
+	current int
+}
+
+// weightedPicker is a smooth weighted round robin: over any run of picks each
+// instance gets its share of the total weight, without bursts to one
+// instance.
+type weightedPicker struct {
+	mu    sync.Mutex
+	conns []*weightedConn
+	total int
+}
+
+func (p *weightedPicker) Pick(balancer.PickInfo) (balancer.PickResult, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var best *weightedConn
+	for _, c := range p.conns {
+		c.current += c.weight
+		if best == nil || c.current > best.current {
+			best = c
+		}
+	}
+	best.current -= p.total
+	return balancer.PickResult{SubConn: best.sc}, nil
+}
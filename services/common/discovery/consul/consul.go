@@ -2,19 +2,27 @@ package consul
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"log"
-	"strconv"
-	"strings"
+	"time"
 
+	"github.com/daffaromero/retries/services/common/discovery"
 	consul "github.com/hashicorp/consul/api"
 )
 
+const (
+	// watchWaitTime bounds how long a blocking query waits for a change.
+	watchWaitTime   = 5 * time.Minute
+	minRetryBackoff = 500 * time.Millisecond
+	maxRetryBackoff = 30 * time.Second
+)
+
 type Registry struct {
 	client *consul.Client
 }
 
+var _ discovery.Registry = (*Registry)(nil)
+
 func NewRegistry(addr, serviceName string) (*Registry, error) {
 	config := consul.DefaultConfig()
 	config.Address = addr
@@ -27,33 +35,28 @@ func NewRegistry(addr, serviceName string) (*Registry, error) {
 	return &Registry{client: client}, nil
 }
 
-func (r *Registry) Register(ctx context.Context, instanceId, serviceName, hostPort string, tags []string) error {
-	host, portString, found := strings.Cut(hostPort, ":")
-	if !found {
-		return errors.New("invalid host:port format")
-	}
-
-	port, err := strconv.Atoi(portString)
-	if err != nil {
+func (r *Registry) Register(ctx context.Context, instance discovery.ServiceInstance) error {
+	if err := instance.Validate(); err != nil {
 		return err
 	}
 
 	registration := &consul.AgentServiceRegistration{
-		ID:      instanceId,
-		Name:    serviceName,
-		Port:    port,
-		Address: host,
-		Tags:    tags,
+		ID:      instance.ID,
+		Name:    instance.Name,
+		Port:    instance.Port,
+		Address: instance.Address,
+		Tags:    instance.Tags,
+		Meta:    instance.Metadata,
 		Check: &consul.AgentServiceCheck{
-			CheckID:                        instanceId,
-			TLSSkipVerify:                  true,
+			CheckID:                        instance.ID,
+			Status:                         consul.HealthPassing,
 			TTL:                            "5s",
 			Timeout:                        "1s",
 			DeregisterCriticalServiceAfter: "10s",
 		},
 	}
 
-	err = r.client.Agent().ServiceRegister(registration)
+	err := r.client.Agent().ServiceRegister(registration)
 	if err != nil {
 		return fmt.Errorf("failed to register service: %w", err)
 	}
@@ -63,18 +66,90 @@ func (r *Registry) Register(ctx context.Context, instanceId, serviceName, hostPo
 
 func (r *Registry) Deregister(ctx context.Context, instanceId string, serviceName string) error {
 	log.Printf("deregistering service %s", instanceId)
-	return r.client.Agent().CheckDeregister(instanceId)
+	return r.client.Agent().ServiceDeregister(instanceId)
 }
 
 func (r *Registry) HealthCheck(instanceId string, serviceName string) error {
 	return r.client.Agent().UpdateTTL(instanceId, "online", consul.HealthPassing)
 }
 
-func (r *Registry) Discover(ctx context.Context, serviceName string) ([]*consul.ServiceEntry, error) {
-	instances, _, err := r.client.Health().Service(serviceName, "", true, nil)
+func (r *Registry) Discover(ctx context.Context, serviceName string) ([]discovery.ServiceInstance, error) {
+	entries, _, err := r.client.Health().Service(serviceName, "", true, (&consul.QueryOptions{}).WithContext(ctx))
 	if err != nil {
 		return nil, err
 	}
 
-	return instances, nil
+	return toInstances(entries), nil
+}
+
+// Watch follows serviceName with blocking queries, so changes arrive as soon
+// as the agent sees them. Failed queries are retried with backoff.
+func (r *Registry) Watch(ctx context.Context, serviceName string) (<-chan []discovery.ServiceInstance, error) {
+	ch := make(chan []discovery.ServiceInstance)
+
+	go func() {
+		defer close(ch)
+
+		var (
+			index   uint64
+			last    []discovery.ServiceInstance
+			sent    bool
+			backoff = minRetryBackoff
+		)
+		for {
+			opts := (&consul.QueryOptions{WaitIndex: index, WaitTime: watchWaitTime}).WithContext(ctx)
+			entries, meta, err := r.client.Health().Service(serviceName, "", true, opts)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				log.Printf("Failed to watch %s, retrying in %s: %v", serviceName, backoff, err)
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(backoff):
+				}
+				backoff = min(backoff*2, maxRetryBackoff)
+				continue
+			}
+			backoff = minRetryBackoff
+
+			// An index that goes backwards means the agent's state was reset;
+			// start over rather than block on an index it will never reach.
+			if meta.LastIndex < index {
+				index = 0
+			} else {
+				index = meta.LastIndex
+			}
+
+			instances := toInstances(entries)
+			if sent && discovery.SameInstances(last, instances) {
+				continue
+			}
+			select {
+			case ch <- instances:
+				last, sent = instances, true
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+func toInstances(entries []*consul.ServiceEntry) []discovery.ServiceInstance {
+	instances := make([]discovery.ServiceInstance, 0, len(entries))
+	for _, e := range entries {
+		instances = append(instances, discovery.ServiceInstance{
+			ID:       e.Service.ID,
+			Name:     e.Service.Service,
+			Address:  e.Service.Address,
+			Port:     e.Service.Port,
+			Tags:     e.Service.Tags,
+			Metadata: e.Service.Meta,
+			Healthy:  e.Checks.AggregatedStatus() == consul.HealthPassing,
+		})
+	}
+	return instances
 }
@@ -0,0 +1,25 @@
+package consul_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/daffaromero/retries/services/common/discovery"
+	"github.com/daffaromero/retries/services/common/discovery/consul"
+	"github.com/daffaromero/retries/services/common/discovery/registrytest"
+)
+
+// TestConformance runs against the consul agent at CONSUL_TEST_ADDR.
+func TestConformance(t *testing.T) {
+	addr := os.Getenv("CONSUL_TEST_ADDR")
+	if addr == "" {
+		t.Skip("CONSUL_TEST_ADDR not set")
+	}
+	registrytest.Run(t, func(t *testing.T) discovery.Registry {
+		r, err := consul.NewRegistry(addr, "conformance")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	})
+}
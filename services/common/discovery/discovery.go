@@ -1,22 +1,41 @@
 package discovery
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"math/rand"
+	"sync"
 	"time"
 
-	consul "github.com/hashicorp/consul/api"
+	"github.com/daffaromero/retries/services/common/resilience"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials/insecure"
+	_ "google.golang.org/grpc/health"
 )
 
+const (
+	OrderServiceName   = "order-service-grpc"
+	ProductServiceName = "product-service-grpc"
+	PaymentServiceName = "payment-service-grpc"
+)
+
+// Registry is implemented by every service discovery backend. Registering
+// an instance that is already registered replaces it. Discover returns only
+// healthy instances and an empty result, not an error, for unknown services.
+// A registered instance stays healthy for as long as HealthCheck is called at
+// least every few seconds.
+//
+// Watch sends the healthy instances of serviceName at once and again every
+// time that set changes, until ctx is done and the channel is closed.
 type Registry interface {
-	Register(ctx context.Context, instanceID, serverName, hostPort string) error
+	Register(ctx context.Context, instance ServiceInstance) error
 	Deregister(ctx context.Context, instanceID, serviceName string) error
-	Discover(ctx context.Context, serviceName string) ([]*consul.ServiceEntry, error)
+	Discover(ctx context.Context, serviceName string) ([]ServiceInstance, error)
+	Watch(ctx context.Context, serviceName string) (<-chan []ServiceInstance, error)
 	HealthCheck(instanceID, serviceName string) error
 }
 
@@ -24,24 +43,90 @@ func GenerateInstanceID(serviceName string) string {
 	return fmt.Sprintf("%s-%d", serviceName, rand.New(rand.NewSource(time.Now().UnixNano())).Int())
 }
 
+var (
+	loadClientConfig sync.Once
+	resilienceConfig *resilience.Config
+)
+
+// clientConfig loads the resilience config shared by every client this
+// process dials. A broken config file is logged and the defaults are used, so
+// a typo can not take a service down.
+func clientConfig() *resilience.Config {
+	loadClientConfig.Do(func() {
+		cfg, err := resilience.LoadFromEnv()
+		if err != nil {
+			log.Printf("Failed to load resilience config, using defaults: %v", err)
+			cfg = resilience.DefaultConfig()
+		}
+		resilienceConfig = cfg
+	})
+	return resilienceConfig
+}
+
+// ConnectToService dials serviceName through the registry. The connection
+// follows instances as they come and go and spreads calls over them with the
+// load balancing policy configured for the service.
 func ConnectToService(ctx context.Context, serviceName string, registry Registry) (*grpc.ClientConn, error) {
-	addrs, err := registry.Discover(ctx, serviceName)
+	// healthCheckConfig makes the balancer skip instances whose
+	// grpc.health.v1 status is NOT_SERVING.
+	serviceConfig := fmt.Sprintf(`{"loadBalancingConfig": [{%q: {}}], "healthCheckConfig": {"serviceName": %q}}`,
+		balancerName(clientConfig().Balancing(serviceName)), serviceName)
+
+	conn, err := grpc.NewClient(Scheme+":///"+serviceName,
+		grpc.WithResolvers(NewResolverBuilder(registry)),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithChainUnaryInterceptor(
+			resilience.UnaryClientInterceptor(serviceName, clientConfig()),
+			resilience.BreakerUnaryClientInterceptor(serviceName, clientConfig()),
+		),
+		grpc.WithStreamInterceptor(resilience.BreakerStreamClientInterceptor(serviceName, clientConfig())),
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(addrs) == 0 {
-		return nil, fmt.Errorf("no instances of %s available", serviceName)
-	}
+	clients.Lock()
+	clients.conns = append(clients.conns, client{service: serviceName, conn: conn})
+	clients.Unlock()
+
+	return conn, nil
+}
 
-	log.Printf("Discovered %d instances of %s", len(addrs), serviceName)
+type client struct {
+	service string
+	conn    *grpc.ClientConn
+}
 
-	serviceEntry := addrs[rand.Intn(len(addrs))]
+// clients are the connections ConnectToService dialled, for CheckClients.
+var clients struct {
+	sync.Mutex
+	conns []client
+}
 
-	conn, err := grpc.NewClient(fmt.Sprintf("%s:%d", serviceEntry.Service.Address, serviceEntry.Service.Port), grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
-	if err != nil {
-		return nil, err
-	}
+// CheckClients reports an error if any connection dialled by
+// ConnectToService is failing to reach its service. Idle connections are
+// woken so the next check sees their real state, and closed ones are
+// forgotten.
+func CheckClients(ctx context.Context) error {
+	clients.Lock()
+	defer clients.Unlock()
 
-	return conn, nil
+	var errs []error
+	open := clients.conns[:0]
+	for _, c := range clients.conns {
+		switch state := c.conn.GetState(); state {
+		case connectivity.Shutdown:
+			continue
+		case connectivity.Idle:
+			c.conn.Connect()
+		case connectivity.TransientFailure:
+			errs = append(errs, fmt.Errorf("%s: %s", c.service, state))
+		}
+		open = append(open, c)
+	}
+	clear(clients.conns[len(open):])
+	clients.conns = open
+	return errors.Join(errs...)
 }
@@ -0,0 +1,167 @@
+// Package dns is a discovery.Registry that finds instances through DNS, for
+// environments such as Docker Compose or Kubernetes that already publish
+// their services there.
+//
+// A service is looked up as the SRV record _grpc._tcp.<name>[.<domain>].
+// Without SRV records, the A records of <name>[.<domain>] are used with the
+// configured default port. Instances registered at runtime live in memory
+// next to them, with the same TTL as the memory registry.
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/daffaromero/retries/services/common/discovery"
+	"github.com/daffaromero/retries/services/common/discovery/memory"
+)
+
+// pollInterval is how often a watched service is looked up again. DNS has
+// no change notifications.
+const pollInterval = 10 * time.Second
+
+// Resolver is the part of *net.Resolver the registry uses.
+type Resolver interface {
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+type Registry struct {
+	local       *memory.Registry
+	resolver    Resolver
+	domain      string
+	defaultPort int
+}
+
+var _ discovery.Registry = (*Registry)(nil)
+
+// NewRegistry looks services up with resolver, appending domain to their
+// names when it is set. A zero defaultPort disables the A record fallback.
+func NewRegistry(resolver Resolver, domain string, defaultPort int) *Registry {
+	return &Registry{
+		local:       memory.NewRegistry(),
+		resolver:    resolver,
+		domain:      strings.Trim(domain, "."),
+		defaultPort: defaultPort,
+	}
+}
+
+// Close releases the in-memory registrations.
+func (r *Registry) Close() error {
+	return r.local.Close()
+}
+
+func (r *Registry) Register(ctx context.Context, instance discovery.ServiceInstance) error {
+	return r.local.Register(ctx, instance)
+}
+
+func (r *Registry) Deregister(ctx context.Context, instanceID, serviceName string) error {
+	return r.local.Deregister(ctx, instanceID, serviceName)
+}
+
+func (r *Registry) HealthCheck(instanceID, serviceName string) error {
+	return r.local.HealthCheck(instanceID, serviceName)
+}
+
+func (r *Registry) Discover(ctx context.Context, serviceName string) ([]discovery.ServiceInstance, error) {
+	if err := r.refresh(ctx, serviceName); err != nil {
+		return nil, err
+	}
+	return r.local.Discover(ctx, serviceName)
+}
+
+// Watch looks serviceName up every pollInterval and reports the changes.
+func (r *Registry) Watch(ctx context.Context, serviceName string) (<-chan []discovery.ServiceInstance, error) {
+	if err := r.refresh(ctx, serviceName); err != nil {
+		log.Printf("Failed to look up %s: %v", serviceName, err)
+	}
+
+	go func() {
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			if err := r.refresh(ctx, serviceName); err != nil && ctx.Err() == nil {
+				log.Printf("Failed to look up %s: %v", serviceName, err)
+			}
+		}
+	}()
+
+	return r.local.Watch(ctx, serviceName)
+}
+
+// refresh replaces the DNS instances of serviceName with a fresh lookup.
+func (r *Registry) refresh(ctx context.Context, serviceName string) error {
+	instances, err := r.lookup(ctx, serviceName)
+	if err != nil {
+		return err
+	}
+	r.local.SetStatic(serviceName, instances)
+	return nil
+}
+
+func (r *Registry) lookup(ctx context.Context, serviceName string) ([]discovery.ServiceInstance, error) {
+	host := serviceName
+	if r.domain != "" {
+		host += "." + r.domain
+	}
+
+	_, records, err := r.resolver.LookupSRV(ctx, "grpc", "tcp", host)
+	if err != nil && !notFound(err) {
+		return nil, fmt.Errorf("failed to look up SRV records of %s: %w", host, err)
+	}
+	if len(records) > 0 {
+		instances := make([]discovery.ServiceInstance, 0, len(records))
+		for _, srv := range records {
+			inst := discovery.ServiceInstance{
+				Name:    serviceName,
+				Address: strings.TrimSuffix(srv.Target, "."),
+				Port:    int(srv.Port),
+			}
+			inst.ID = inst.HostPort()
+			if srv.Weight > 0 {
+				inst.Tags = []string{"weight=" + strconv.Itoa(int(srv.Weight))}
+			}
+			instances = append(instances, inst)
+		}
+		return instances, nil
+	}
+
+	if r.defaultPort == 0 {
+		return nil, nil
+	}
+	addrs, err := r.resolver.LookupHost(ctx, host)
+	if err != nil {
+		if notFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to look up %s: %w", host, err)
+	}
+	instances := make([]discovery.ServiceInstance, 0, len(addrs))
+	for _, addr := range addrs {
+		inst := discovery.ServiceInstance{
+			Name:    serviceName,
+			Address: addr,
+			Port:    r.defaultPort,
+		}
+		inst.ID = inst.HostPort()
+		instances = append(instances, inst)
+	}
+	return instances, nil
+}
+
+func notFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
@@ -0,0 +1,94 @@
+package dns_test
+
+import (
+	"context"
+	"net"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/daffaromero/retries/services/common/discovery"
+	"github.com/daffaromero/retries/services/common/discovery/dns"
+	"github.com/daffaromero/retries/services/common/discovery/registrytest"
+)
+
+type fakeResolver struct {
+	srv   map[string][]*net.SRV
+	hosts map[string][]string
+}
+
+func (f fakeResolver) LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error) {
+	cname := "_" + service + "._" + proto + "." + name
+	records, ok := f.srv[cname]
+	if !ok {
+		return "", nil, &net.DNSError{Err: "no such host", Name: cname, IsNotFound: true}
+	}
+	return cname, records, nil
+}
+
+func (f fakeResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	addrs, ok := f.hosts[host]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	return addrs, nil
+}
+
+func TestConformance(t *testing.T) {
+	registrytest.Run(t, func(t *testing.T) discovery.Registry {
+		r := dns.NewRegistry(fakeResolver{}, "", 50051)
+		t.Cleanup(func() { r.Close() })
+		return r
+	})
+}
+
+func TestLookup(t *testing.T) {
+	resolver := fakeResolver{
+		srv: map[string][]*net.SRV{
+			"_grpc._tcp.product-service-grpc.svc.local": {
+				{Target: "product-1.svc.local.", Port: 50051, Weight: 3},
+				{Target: "product-2.svc.local.", Port: 50052},
+			},
+		},
+		hosts: map[string][]string{
+			"order-service-grpc.svc.local": {"10.0.0.1", "10.0.0.2"},
+		},
+	}
+
+	for _, tc := range []struct {
+		name        string
+		defaultPort int
+		service     string
+		want        []string
+	}{
+		{"srv", 0, "product-service-grpc", []string{"product-1.svc.local:50051 [weight=3]", "product-2.svc.local:50052 []"}},
+		{"a records", 9000, "order-service-grpc", []string{"10.0.0.1:9000 []", "10.0.0.2:9000 []"}},
+		{"a records without a default port", 0, "order-service-grpc", nil},
+		{"unknown", 9000, "payment-service-grpc", nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			r := dns.NewRegistry(resolver, "svc.local.", tc.defaultPort)
+			defer r.Close()
+
+			instances, err := r.Discover(context.Background(), tc.service)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, inst := range instances {
+				if inst.Name != tc.service || !inst.Healthy {
+					t.Errorf("unexpected instance %+v", inst)
+				}
+				got = append(got, inst.HostPort()+" "+formatTags(inst.Tags))
+			}
+			slices.Sort(got)
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func formatTags(tags []string) string {
+	return "[" + strings.Join(tags, " ") + "]"
+}
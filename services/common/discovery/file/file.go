@@ -0,0 +1,182 @@
+// Package file is a discovery.Registry that reads instances from a YAML or
+// JSON file and reloads it when it changes, for running the services without
+// a Consul agent:
+//
+//	services:
+//	  product-service-grpc:
+//	    - address: product-service
+//	      port: 50051
+//	      tags: [weight=2]
+//
+// Instances in the file are always healthy. Instances registered at runtime
+// live in memory next to them, with the same TTL as the memory registry.
+package file
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/daffaromero/retries/services/common/discovery"
+	"github.com/daffaromero/retries/services/common/discovery/memory"
+	"gopkg.in/yaml.v3"
+)
+
+// reloadInterval is how often the file is checked for changes.
+const reloadInterval = time.Second
+
+type instanceEntry struct {
+	ID       string            `yaml:"id"`
+	Address  string            `yaml:"address"`
+	Port     int               `yaml:"port"`
+	Tags     []string          `yaml:"tags"`
+	Metadata map[string]string `yaml:"metadata"`
+}
+
+type document struct {
+	Services map[string][]instanceEntry `yaml:"services"`
+}
+
+type Registry struct {
+	local *memory.Registry
+	path  string
+
+	mu       sync.Mutex
+	modTime  time.Time
+	size     int64
+	services map[string]bool
+
+	stop      chan struct{}
+	closeOnce sync.Once
+}
+
+var _ discovery.Registry = (*Registry)(nil)
+
+// NewRegistry loads path and watches it for changes until Close is called.
+func NewRegistry(path string) (*Registry, error) {
+	r := &Registry{
+		local:    memory.NewRegistry(),
+		path:     path,
+		services: map[string]bool{},
+		stop:     make(chan struct{}),
+	}
+	if err := r.reload(); err != nil {
+		r.local.Close()
+		return nil, err
+	}
+	go r.watchFile()
+	return r, nil
+}
+
+// Close stops reloading the file.
+func (r *Registry) Close() error {
+	r.closeOnce.Do(func() { close(r.stop) })
+	return r.local.Close()
+}
+
+func (r *Registry) Register(ctx context.Context, instance discovery.ServiceInstance) error {
+	return r.local.Register(ctx, instance)
+}
+
+func (r *Registry) Deregister(ctx context.Context, instanceID, serviceName string) error {
+	return r.local.Deregister(ctx, instanceID, serviceName)
+}
+
+func (r *Registry) HealthCheck(instanceID, serviceName string) error {
+	return r.local.HealthCheck(instanceID, serviceName)
+}
+
+func (r *Registry) Discover(ctx context.Context, serviceName string) ([]discovery.ServiceInstance, error) {
+	return r.local.Discover(ctx, serviceName)
+}
+
+func (r *Registry) Watch(ctx context.Context, serviceName string) (<-chan []discovery.ServiceInstance, error) {
+	return r.local.Watch(ctx, serviceName)
+}
+
+func (r *Registry) watchFile() {
+	ticker := time.NewTicker(reloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+		}
+		if err := r.reload(); err != nil {
+			log.Printf("Failed to reload %s, keeping the previous instances: %v", r.path, err)
+		}
+	}
+}
+
+// reload reads the file if it changed since the last read and replaces the
+// instances it lists. A file that fails to parse changes nothing.
+func (r *Registry) reload() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return err
+	}
+	if info.ModTime().Equal(r.modTime) && info.Size() == r.size {
+		return nil
+	}
+
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		return err
+	}
+	services, err := parse(data)
+	if err != nil {
+		return fmt.Errorf("invalid discovery file %s: %w", r.path, err)
+	}
+
+	for name := range r.services {
+		if _, ok := services[name]; !ok {
+			r.local.SetStatic(name, nil)
+		}
+	}
+	r.services = map[string]bool{}
+	for name, instances := range services {
+		r.local.SetStatic(name, instances)
+		r.services[name] = true
+	}
+	r.modTime, r.size = info.ModTime(), info.Size()
+	return nil
+}
+
+// parse reads a discovery file. JSON is valid YAML, so one parser serves
+// both formats.
+func parse(data []byte) (map[string][]discovery.ServiceInstance, error) {
+	var doc document
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	services := map[string][]discovery.ServiceInstance{}
+	for name, entries := range doc.Services {
+		for _, e := range entries {
+			inst := discovery.ServiceInstance{
+				ID:       e.ID,
+				Name:     name,
+				Address:  e.Address,
+				Port:     e.Port,
+				Tags:     e.Tags,
+				Metadata: e.Metadata,
+			}
+			if inst.ID == "" {
+				inst.ID = name + "-" + inst.HostPort()
+			}
+			if err := inst.Validate(); err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+			services[name] = append(services[name], inst)
+		}
+	}
+	return services, nil
+}
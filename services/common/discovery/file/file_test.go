@@ -0,0 +1,104 @@
+package file_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/daffaromero/retries/services/common/discovery"
+	"github.com/daffaromero/retries/services/common/discovery/file"
+	"github.com/daffaromero/retries/services/common/discovery/registrytest"
+)
+
+func newRegistry(t *testing.T, content string) *file.Registry {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "services.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	r, err := file.NewRegistry(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { r.Close() })
+	return r
+}
+
+func TestConformance(t *testing.T) {
+	registrytest.Run(t, func(t *testing.T) discovery.Registry {
+		return newRegistry(t, "services: {}\n")
+	})
+}
+
+func TestReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "services.json")
+	write := func(content string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write(`{"services": {"product-service-grpc": [{"address": "10.0.0.1", "port": 50051, "tags": ["weight=2"]}]}}`)
+
+	r, err := file.NewRegistry(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch, err := r.Watch(ctx, "product-service-grpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := <-ch
+	if len(got) != 1 || got[0].HostPort() != "10.0.0.1:50051" || got[0].ID != "product-service-grpc-10.0.0.1:50051" || !got[0].Healthy {
+		t.Fatalf("initial instances = %+v", got)
+	}
+
+	write("services:\n  product-service-grpc: [}\n")
+	write(`services:
+  product-service-grpc:
+    - id: product-1
+      address: 10.0.0.1
+      port: 50051
+    - id: product-2
+      address: 10.0.0.2
+      port: 50051
+`)
+	select {
+	case got = <-ch:
+	case <-time.After(5 * time.Second):
+		t.Fatal("file change not picked up")
+	}
+	if len(got) != 2 {
+		t.Errorf("reloaded instances = %+v, want 2", got)
+	}
+
+	write("services: {}\n")
+	select {
+	case got = <-ch:
+	case <-time.After(5 * time.Second):
+		t.Fatal("file change not picked up")
+	}
+	if len(got) != 0 {
+		t.Errorf("instances after removal = %+v, want none", got)
+	}
+}
+
+func TestInvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "services.yaml")
+	if err := os.WriteFile(path, []byte("services:\n  svc:\n    - address: x\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := file.NewRegistry(path); err == nil {
+		t.Error("NewRegistry accepted an instance without a port")
+	}
+	if _, err := file.NewRegistry(path + ".missing"); err == nil {
+		t.Error("NewRegistry accepted a missing file")
+	}
+}
@@ -0,0 +1,82 @@
+package discovery
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ServiceInstance is one running copy of a service as a Registry sees it,
+// independent of the backend that stores it.
+type ServiceInstance struct {
+	ID       string            `json:"id"`
+	Name     string            `json:"name"`
+	Address  string            `json:"address"`
+	Port     int               `json:"port"`
+	Tags     []string          `json:"tags,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Healthy reports whether the instance's heartbeats are current.
+	Healthy bool `json:"healthy"`
+}
+
+// NewInstance describes instanceID of serviceName listening on hostPort.
+func NewInstance(instanceID, serviceName, hostPort string, tags ...string) (ServiceInstance, error) {
+	host, portString, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		return ServiceInstance{}, fmt.Errorf("invalid host:port %q: %w", hostPort, err)
+	}
+	port, err := strconv.Atoi(portString)
+	if err != nil {
+		return ServiceInstance{}, fmt.Errorf("invalid port in %q: %w", hostPort, err)
+	}
+	return ServiceInstance{
+		ID:      instanceID,
+		Name:    serviceName,
+		Address: host,
+		Port:    port,
+		Tags:    tags,
+	}, nil
+}
+
+// HostPort returns the address clients dial to reach the instance.
+func (i ServiceInstance) HostPort() string {
+	return net.JoinHostPort(i.Address, strconv.Itoa(i.Port))
+}
+
+// Validate reports whether the instance can be registered.
+func (i ServiceInstance) Validate() error {
+	switch {
+	case i.ID == "":
+		return errors.New("instance id is required")
+	case i.Name == "":
+		return errors.New("service name is required")
+	case i.Port <= 0 || i.Port > 65535:
+		return fmt.Errorf("invalid port %d", i.Port)
+	}
+	return nil
+}
+
+// SameInstances reports whether a and b hold the same instances, in any
+// order. Watch implementations use it to drop updates that change nothing.
+func SameInstances(a, b []ServiceInstance) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	byID := func(x, y ServiceInstance) int { return strings.Compare(x.ID, y.ID) }
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.SortFunc(a, byID)
+	slices.SortFunc(b, byID)
+	return slices.EqualFunc(a, b, func(x, y ServiceInstance) bool {
+		return x.ID == y.ID &&
+			x.Name == y.Name &&
+			x.Address == y.Address &&
+			x.Port == y.Port &&
+			x.Healthy == y.Healthy &&
+			slices.Equal(x.Tags, y.Tags) &&
+			maps.Equal(x.Metadata, y.Metadata)
+	})
+}
@@ -0,0 +1,164 @@
+package discovery
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	// heartbeatInterval keeps well inside the 5s TTL both registries give an
+	// instance, so a single late heartbeat does not mark it unhealthy.
+	heartbeatInterval = time.Second
+	deregisterTimeout = 5 * time.Second
+)
+
+// Registration is an instance kept registered by Register. It heartbeats
+// until the ctx given to Register is done or Close is called, and then
+// deregisters. Reacting to signals is left to the caller, which knows in
+// what order the service has to stop.
+type Registration struct {
+	registry Registry
+	instance ServiceInstance
+	cancel   context.CancelFunc
+	done     chan struct{}
+	err      error
+
+	mu       sync.Mutex
+	ready    func() error
+	lastErr  error
+	withheld bool
+}
+
+// Register registers a new instance of serviceName listening on hostPort and
+// keeps it registered until ctx is done or Close is called. If the registry
+// forgets the instance, for example after an agent restart, it is registered
+// again.
+//
+//	reg, err := discovery.Register(ctx, registry, discovery.OrderServiceName, cfg.GRPCHost)
+//	...
+//	defer reg.Close()
+func Register(ctx context.Context, registry Registry, serviceName, hostPort string, tags ...string) (*Registration, error) {
+	instance, err := NewInstance(GenerateInstanceID(serviceName), serviceName, hostPort, tags...)
+	if err != nil {
+		return nil, err
+	}
+	if err := registry.Register(ctx, instance); err != nil {
+		return nil, err
+	}
+	log.Printf("Registered %s as %s", serviceName, instance.ID)
+
+	ctx, cancel := context.WithCancel(ctx)
+	r := &Registration{
+		registry: registry,
+		instance: instance,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+	go r.heartbeat(ctx)
+	return r, nil
+}
+
+// ID returns the registered instance ID.
+func (r *Registration) ID() string {
+	return r.instance.ID
+}
+
+// Done is closed once the instance has been deregistered.
+func (r *Registration) Done() <-chan struct{} {
+	return r.done
+}
+
+// ReadyWhen holds heartbeats back while ready returns an error, so the
+// registry marks the instance unhealthy and stops sending it traffic until it
+// recovers.
+func (r *Registration) ReadyWhen(ready func() error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ready = ready
+}
+
+// Err returns why the last heartbeat or registration failed, or nil if it
+// succeeded. Heartbeats held back by ReadyWhen do not change it.
+func (r *Registration) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.lastErr
+}
+
+// Close stops the heartbeat and deregisters the instance. It is safe to call
+// more than once and after ctx is done.
+func (r *Registration) Close() error {
+	r.cancel()
+	<-r.done
+	return r.err
+}
+
+func (r *Registration) heartbeat(ctx context.Context) {
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			r.deregister()
+			return
+		case <-ticker.C:
+		}
+
+		if !r.shouldBeat() {
+			continue
+		}
+
+		err := r.registry.HealthCheck(r.instance.ID, r.instance.Name)
+		if err != nil {
+			log.Printf("Heartbeat of %s failed, registering again: %v", r.instance.ID, err)
+			if err = r.registry.Register(ctx, r.instance); err != nil {
+				log.Printf("Failed to register %s again: %v", r.instance.ID, err)
+			}
+		}
+		r.mu.Lock()
+		r.lastErr = err
+		r.mu.Unlock()
+	}
+}
+
+// shouldBeat reports whether the instance is ready for traffic, logging when
+// that changes.
+func (r *Registration) shouldBeat() bool {
+	r.mu.Lock()
+	ready := r.ready
+	r.mu.Unlock()
+
+	var err error
+	if ready != nil {
+		err = ready()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	switch {
+	case err != nil && !r.withheld:
+		log.Printf("Holding back heartbeats of %s, not ready: %v", r.instance.ID, err)
+	case err == nil && r.withheld:
+		log.Printf("Resuming heartbeats of %s", r.instance.ID)
+	}
+	r.withheld = err != nil
+	return !r.withheld
+}
+
+func (r *Registration) deregister() {
+	defer close(r.done)
+	r.cancel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), deregisterTimeout)
+	defer cancel()
+
+	r.err = r.registry.Deregister(ctx, r.instance.ID, r.instance.Name)
+	if r.err != nil {
+		log.Printf("Failed to deregister %s: %v", r.instance.ID, r.err)
+		return
+	}
+	log.Printf("Deregistered %s", r.instance.ID)
+}
@@ -0,0 +1,63 @@
+package discovery_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/daffaromero/retries/services/common/discovery"
+	"github.com/daffaromero/retries/services/common/discovery/memory"
+)
+
+func registered(t *testing.T, registry discovery.Registry) int {
+	t.Helper()
+	instances, err := registry.Discover(context.Background(), "svc")
+	if err != nil {
+		return 0
+	}
+	return len(instances)
+}
+
+func TestRegistrationClose(t *testing.T) {
+	registry := memory.NewRegistry()
+	defer registry.Close()
+
+	reg, err := discovery.Register(context.Background(), registry, "svc", "127.0.0.1:9000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := registered(t, registry); n != 1 {
+		t.Fatalf("instances after Register = %d, want 1", n)
+	}
+
+	if err := reg.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if n := registered(t, registry); n != 0 {
+		t.Errorf("instances after Close = %d, want 0", n)
+	}
+	if err := reg.Close(); err != nil {
+		t.Errorf("second Close = %v", err)
+	}
+}
+
+func TestRegistrationEndsWithContext(t *testing.T) {
+	registry := memory.NewRegistry()
+	defer registry.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	reg, err := discovery.Register(ctx, registry, "svc", "127.0.0.1:9000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+
+	select {
+	case <-reg.Done():
+	case <-time.After(time.Second):
+		t.Fatal("instance not deregistered after ctx was cancelled")
+	}
+	if n := registered(t, registry); n != 0 {
+		t.Errorf("instances after cancel = %d, want 0", n)
+	}
+}
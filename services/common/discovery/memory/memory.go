@@ -3,36 +3,85 @@ package memory
 import (
 	"context"
 	"errors"
+	"maps"
+	"slices"
+	"strings"
 	"sync"
 	"time"
+
+	"github.com/daffaromero/retries/services/common/discovery"
+)
+
+const (
+	// ttl is how long an instance stays healthy after its last heartbeat,
+	// matching the TTL check the consul registry registers.
+	ttl = 5 * time.Second
+	// evictAfter is how long an unhealthy instance is kept before it is
+	// dropped, like consul's DeregisterCriticalServiceAfter.
+	evictAfter    = 10 * time.Second
+	sweepInterval = time.Second
 )
 
 type Registry struct {
 	sync.RWMutex
-	addrs map[string]map[string]*serviceInstance
+	addrs    map[string]map[string]*serviceInstance
+	static   map[string][]discovery.ServiceInstance
+	watchers map[string]map[chan struct{}]struct{}
+
+	stop      chan struct{}
+	closeOnce sync.Once
 }
 
+var _ discovery.Registry = (*Registry)(nil)
+
 type serviceInstance struct {
-	hostPort   string
+	instance   discovery.ServiceInstance
 	lastActive time.Time
+	// healthy is the state watchers were last told about.
+	healthy bool
+}
+
+func (i *serviceInstance) alive(now time.Time) bool {
+	return now.Sub(i.lastActive) <= ttl
 }
 
+// NewRegistry returns an empty registry. It expires and evicts instances in
+// the background until Close is called.
 func NewRegistry() *Registry {
-	return &Registry{addrs: map[string]map[string]*serviceInstance{}}
+	r := &Registry{
+		addrs:    map[string]map[string]*serviceInstance{},
+		static:   map[string][]discovery.ServiceInstance{},
+		watchers: map[string]map[chan struct{}]struct{}{},
+		stop:     make(chan struct{}),
+	}
+	go r.sweep()
+	return r
 }
 
-func (r *Registry) Register(ctx context.Context, instanceId, serviceName, hostPort string) error {
+// Close stops expiring instances.
+func (r *Registry) Close() error {
+	r.closeOnce.Do(func() { close(r.stop) })
+	return nil
+}
+
+func (r *Registry) Register(ctx context.Context, instance discovery.ServiceInstance) error {
+	if err := instance.Validate(); err != nil {
+		return err
+	}
+
 	r.Lock()
 	defer r.Unlock()
 
-	if _, ok := r.addrs[serviceName]; !ok {
-		r.addrs[serviceName] = map[string]*serviceInstance{}
+	if _, ok := r.addrs[instance.Name]; !ok {
+		r.addrs[instance.Name] = map[string]*serviceInstance{}
 	}
 
-	r.addrs[serviceName][instanceId] = &serviceInstance{
-		hostPort:   hostPort,
+	r.addrs[instance.Name][instance.ID] = &serviceInstance{
+		instance:   clone(instance),
 		lastActive: time.Now(),
+		healthy:    true,
 	}
+	r.notify(instance.Name)
 	return nil
 }
 
@@ -40,11 +89,12 @@ func (r *Registry) Deregister(ctx context.Context, instanceId, serviceName strin
 	r.Lock()
 	defer r.Unlock()
 
-	if _, ok := r.addrs[serviceName]; !ok {
+	if _, ok := r.addrs[serviceName][instanceId]; !ok {
 		return nil
 	}
 
 	delete(r.addrs[serviceName], instanceId)
+	r.notify(serviceName)
 	return nil
 }
 
@@ -56,45 +106,206 @@ func (r *Registry) HealthCheck(instanceId, serviceName string) error {
 		return errors.New("service not registered")
 	}
 
-	if _, ok := r.addrs[serviceName][instanceId]; !ok {
+	inst, ok := r.addrs[serviceName][instanceId]
+	if !ok {
 		return errors.New("service instance not registered")
 	}
 
-	r.addrs[serviceName][instanceId].lastActive = time.Now()
+	inst.lastActive = time.Now()
+	if !inst.healthy {
+		inst.healthy = true
+		r.notify(serviceName)
+	}
 	return nil
 }
 
-func (r *Registry) Discover(ctx context.Context, serviceName string) ([]string, error) {
-	r.RLock()
-	defer r.RUnlock()
+// SetStatic replaces the static instances of serviceName: instances that
+// come from somewhere other than Register, such as a file or DNS, and are
+// healthy without heartbeats. Watchers are woken only if the set changed.
+func (r *Registry) SetStatic(serviceName string, instances []discovery.ServiceInstance) {
+	r.Lock()
+	defer r.Unlock()
 
-	if len(r.addrs[serviceName]) == 0 {
-		return nil, errors.New("no service address found")
+	if discovery.SameInstances(r.static[serviceName], instances) {
+		return
 	}
+	if len(instances) == 0 {
+		delete(r.static, serviceName)
+	} else {
+		cloned := make([]discovery.ServiceInstance, 0, len(instances))
+		for _, inst := range instances {
+			cloned = append(cloned, clone(inst))
+		}
+		r.static[serviceName] = cloned
+	}
+	r.notify(serviceName)
+}
 
-	var res []string
+// Discover returns the static instances of serviceName and those that sent a
+// heartbeat within the last ttl.
+func (r *Registry) Discover(ctx context.Context, serviceName string) ([]discovery.ServiceInstance, error) {
+	r.RLock()
+	defer r.RUnlock()
+
+	now := time.Now()
+	res := []discovery.ServiceInstance{}
 	for _, i := range r.addrs[serviceName] {
-		res = append(res, i.hostPort)
+		if !i.alive(now) {
+			continue
+		}
+		inst := clone(i.instance)
+		inst.Healthy = true
+		res = append(res, inst)
+	}
+	for _, i := range r.static[serviceName] {
+		inst := clone(i)
+		inst.Healthy = true
+		res = append(res, inst)
 	}
 
 	return res, nil
 }
 
-func (r *Registry) ServiceAddresses(ctx context.Context, serviceName string) ([]string, error) {
+// InstanceState is an instance as List reports it.
+type InstanceState struct {
+	discovery.ServiceInstance
+	// LastHeartbeat is zero for static instances.
+	LastHeartbeat time.Time `json:"last_heartbeat"`
+}
+
+// List returns every instance the registry holds, keyed by service name,
+// including the unhealthy ones that have not been evicted yet.
+func (r *Registry) List() map[string][]InstanceState {
 	r.RLock()
 	defer r.RUnlock()
 
-	if len(r.addrs[serviceName]) == 0 {
-		return nil, errors.New("no service address found")
+	now := time.Now()
+	res := map[string][]InstanceState{}
+	for name, instances := range r.addrs {
+		for _, i := range instances {
+			inst := clone(i.instance)
+			inst.Healthy = i.alive(now)
+			res[name] = append(res[name], InstanceState{ServiceInstance: inst, LastHeartbeat: i.lastActive})
+		}
 	}
+	for name, instances := range r.static {
+		for _, i := range instances {
+			inst := clone(i)
+			inst.Healthy = true
+			res[name] = append(res[name], InstanceState{ServiceInstance: inst})
+		}
+	}
+	for _, instances := range res {
+		slices.SortFunc(instances, func(a, b InstanceState) int {
+			return strings.Compare(a.ID, b.ID)
+		})
+	}
+	return res
+}
 
-	var res []string
-	for _, i := range r.addrs[serviceName] {
-		if i.lastActive.Before(time.Now().Add(-5 * time.Second)) {
-			continue
+// Watch sends the result of Discover whenever an instance of serviceName is
+// registered, deregistered, expires or recovers, or its static instances
+// change.
+func (r *Registry) Watch(ctx context.Context, serviceName string) (<-chan []discovery.ServiceInstance, error) {
+	changed := make(chan struct{}, 1)
+	changed <- struct{}{}
+
+	r.Lock()
+	if _, ok := r.watchers[serviceName]; !ok {
+		r.watchers[serviceName] = map[chan struct{}]struct{}{}
+	}
+	r.watchers[serviceName][changed] = struct{}{}
+	r.Unlock()
+
+	ch := make(chan []discovery.ServiceInstance)
+	go func() {
+		defer close(ch)
+		defer func() {
+			r.Lock()
+			delete(r.watchers[serviceName], changed)
+			r.Unlock()
+		}()
+
+		var (
+			last []discovery.ServiceInstance
+			sent bool
+		)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-changed:
+			}
+
+			instances, _ := r.Discover(ctx, serviceName)
+			if sent && discovery.SameInstances(last, instances) {
+				continue
+			}
+			select {
+			case ch <- instances:
+				last, sent = instances, true
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// notify wakes the watchers of serviceName. Wake-ups coalesce, so a slow
+// watcher sees the latest state rather than every step. r must be locked.
+func (r *Registry) notify(serviceName string) {
+	for changed := range r.watchers[serviceName] {
+		select {
+		case changed <- struct{}{}:
+		default:
 		}
-		res = append(res, i.hostPort)
 	}
+}
 
-	return res, nil
+// sweep marks instances whose heartbeats stopped as unhealthy and evicts them
+// once they have been silent for evictAfter.
+func (r *Registry) sweep() {
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+		}
+
+		r.Lock()
+		now := time.Now()
+		for name, instances := range r.addrs {
+			changed := false
+			for id, i := range instances {
+				switch {
+				case now.Sub(i.lastActive) > evictAfter:
+					delete(instances, id)
+					changed = changed || i.healthy
+				case i.healthy && !i.alive(now):
+					i.healthy = false
+					changed = true
+				}
+			}
+			if len(instances) == 0 {
+				delete(r.addrs, name)
+			}
+			if changed {
+				r.notify(name)
+			}
+		}
+		r.Unlock()
+	}
+}
+
+// clone copies the tags and metadata of instance so callers can not modify
+// what the registry holds.
+func clone(instance discovery.ServiceInstance) discovery.ServiceInstance {
+	instance.Tags = slices.Clone(instance.Tags)
+	instance.Metadata = maps.Clone(instance.Metadata)
+	return instance
 }
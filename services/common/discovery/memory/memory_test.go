@@ -0,0 +1,17 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/daffaromero/retries/services/common/discovery"
+	"github.com/daffaromero/retries/services/common/discovery/memory"
+	"github.com/daffaromero/retries/services/common/discovery/registrytest"
+)
+
+func TestConformance(t *testing.T) {
+	registrytest.Run(t, func(t *testing.T) discovery.Registry {
+		r := memory.NewRegistry()
+		t.Cleanup(func() { r.Close() })
+		return r
+	})
+}
@@ -0,0 +1,242 @@
+// Package registrytest holds the conformance suite every discovery.Registry
+// backend must pass.
+package registrytest
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/daffaromero/retries/services/common/discovery"
+)
+
+var seq atomic.Int64
+
+// instance returns a registrable instance of a service name unique to this
+// run, so backends shared between tests do not see each other's instances.
+func instance(t *testing.T, port int) discovery.ServiceInstance {
+	t.Helper()
+	n := seq.Add(1)
+	name := strings.ToLower(strings.NewReplacer("/", "-", " ", "-").Replace(t.Name()))
+	service := fmt.Sprintf("%s-%d-%d", name, time.Now().UnixNano(), n)
+	return discovery.ServiceInstance{
+		ID:       service + "-1",
+		Name:     service,
+		Address:  "127.0.0.1",
+		Port:     port,
+		Tags:     []string{"weight=2", "v1"},
+		Metadata: map[string]string{"zone": "a"},
+	}
+}
+
+func discover(t *testing.T, r discovery.Registry, serviceName string) []discovery.ServiceInstance {
+	t.Helper()
+	instances, err := r.Discover(context.Background(), serviceName)
+	if err != nil {
+		t.Fatalf("Discover(%q): %v", serviceName, err)
+	}
+	slices.SortFunc(instances, func(a, b discovery.ServiceInstance) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+	return instances
+}
+
+func register(t *testing.T, r discovery.Registry, inst discovery.ServiceInstance) {
+	t.Helper()
+	if err := r.Register(context.Background(), inst); err != nil {
+		t.Fatalf("Register(%s): %v", inst.ID, err)
+	}
+	t.Cleanup(func() {
+		r.Deregister(context.Background(), inst.ID, inst.Name)
+	})
+}
+
+func sameInstance(got, want discovery.ServiceInstance) bool {
+	return got.ID == want.ID &&
+		got.Name == want.Name &&
+		got.Address == want.Address &&
+		got.Port == want.Port &&
+		slices.Equal(got.Tags, want.Tags) &&
+		maps.Equal(got.Metadata, want.Metadata)
+}
+
+// await reads updates from ch until one satisfies ok.
+func await(t *testing.T, ch <-chan []discovery.ServiceInstance, what string, ok func([]discovery.ServiceInstance) bool) {
+	t.Helper()
+	timeout := time.After(10 * time.Second)
+	for {
+		select {
+		case instances, open := <-ch:
+			if !open {
+				t.Fatalf("watch closed while waiting for %s", what)
+			}
+			if ok(instances) {
+				return
+			}
+		case <-timeout:
+			t.Fatalf("timed out waiting for %s", what)
+		}
+	}
+}
+
+// Run checks the behaviour discovery.Registry promises against the registry
+// newRegistry returns. newRegistry is called once per subtest.
+func Run(t *testing.T, newRegistry func(t *testing.T) discovery.Registry) {
+	t.Run("RegisterDiscover", func(t *testing.T) {
+		r := newRegistry(t)
+		inst := instance(t, 9001)
+		register(t, r, inst)
+
+		got := discover(t, r, inst.Name)
+		if len(got) != 1 {
+			t.Fatalf("Discover returned %d instances, want 1", len(got))
+		}
+		if !sameInstance(got[0], inst) {
+			t.Errorf("Discover returned %+v, want %+v", got[0], inst)
+		}
+		if !got[0].Healthy {
+			t.Error("freshly registered instance is not healthy")
+		}
+	})
+
+	t.Run("UnknownService", func(t *testing.T) {
+		r := newRegistry(t)
+		if got := discover(t, r, instance(t, 9001).Name); len(got) != 0 {
+			t.Errorf("Discover of an unknown service returned %v", got)
+		}
+	})
+
+	t.Run("MultipleInstances", func(t *testing.T) {
+		r := newRegistry(t)
+		a := instance(t, 9001)
+		b := a
+		b.ID += "-b"
+		b.Port = 9002
+		other := instance(t, 9003)
+		register(t, r, a)
+		register(t, r, b)
+		register(t, r, other)
+
+		got := discover(t, r, a.Name)
+		if len(got) != 2 || !sameInstance(got[0], a) || !sameInstance(got[1], b) {
+			t.Errorf("Discover returned %+v, want %s and %s", got, a.ID, b.ID)
+		}
+	})
+
+	t.Run("ReRegisterReplaces", func(t *testing.T) {
+		r := newRegistry(t)
+		inst := instance(t, 9001)
+		register(t, r, inst)
+		inst.Port = 9002
+		inst.Tags = []string{"v2"}
+		register(t, r, inst)
+
+		got := discover(t, r, inst.Name)
+		if len(got) != 1 || !sameInstance(got[0], inst) {
+			t.Errorf("Discover returned %+v, want only %+v", got, inst)
+		}
+	})
+
+	t.Run("Deregister", func(t *testing.T) {
+		r := newRegistry(t)
+		inst := instance(t, 9001)
+		register(t, r, inst)
+		if err := r.Deregister(context.Background(), inst.ID, inst.Name); err != nil {
+			t.Fatalf("Deregister: %v", err)
+		}
+		if got := discover(t, r, inst.Name); len(got) != 0 {
+			t.Errorf("Discover after Deregister returned %v", got)
+		}
+		if err := r.Deregister(context.Background(), inst.ID, inst.Name); err != nil {
+			t.Errorf("second Deregister: %v", err)
+		}
+	})
+
+	t.Run("HealthCheck", func(t *testing.T) {
+		r := newRegistry(t)
+		inst := instance(t, 9001)
+		register(t, r, inst)
+		if err := r.HealthCheck(inst.ID, inst.Name); err != nil {
+			t.Errorf("HealthCheck of a registered instance: %v", err)
+		}
+		if err := r.HealthCheck(inst.ID+"-missing", inst.Name); err == nil {
+			t.Error("HealthCheck of an unregistered instance succeeded")
+		}
+	})
+
+	t.Run("InvalidInstance", func(t *testing.T) {
+		r := newRegistry(t)
+		for name, mutate := range map[string]func(*discovery.ServiceInstance){
+			"no id":   func(i *discovery.ServiceInstance) { i.ID = "" },
+			"no name": func(i *discovery.ServiceInstance) { i.Name = "" },
+			"no port": func(i *discovery.ServiceInstance) { i.Port = 0 },
+		} {
+			inst := instance(t, 9001)
+			mutate(&inst)
+			if err := r.Register(context.Background(), inst); err == nil {
+				r.Deregister(context.Background(), inst.ID, inst.Name)
+				t.Errorf("Register with %s succeeded", name)
+			}
+		}
+	})
+
+	t.Run("ResultsAreCopies", func(t *testing.T) {
+		r := newRegistry(t)
+		inst := instance(t, 9001)
+		register(t, r, inst)
+
+		got := discover(t, r, inst.Name)
+		got[0].Tags[0] = "changed"
+		got[0].Metadata["zone"] = "changed"
+		if again := discover(t, r, inst.Name); !sameInstance(again[0], inst) {
+			t.Errorf("modifying a result changed the registry: %+v", again[0])
+		}
+	})
+	t.Run("Watch", func(t *testing.T) {
+		r := newRegistry(t)
+		a := instance(t, 9001)
+		b := a
+		b.ID += "-b"
+		b.Port = 9002
+		register(t, r, a)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		ch, err := r.Watch(ctx, a.Name)
+		if err != nil {
+			t.Fatalf("Watch: %v", err)
+		}
+
+		await(t, ch, "the current instances", func(got []discovery.ServiceInstance) bool {
+			return len(got) == 1 && sameInstance(got[0], a)
+		})
+		register(t, r, b)
+		await(t, ch, "the registered instance", func(got []discovery.ServiceInstance) bool {
+			return len(got) == 2
+		})
+		if err := r.Deregister(context.Background(), a.ID, a.Name); err != nil {
+			t.Fatalf("Deregister: %v", err)
+		}
+		await(t, ch, "the deregistration", func(got []discovery.ServiceInstance) bool {
+			return len(got) == 1 && sameInstance(got[0], b)
+		})
+
+		cancel()
+		timeout := time.After(10 * time.Second)
+		for {
+			select {
+			case _, open := <-ch:
+				if !open {
+					return
+				}
+			case <-timeout:
+				t.Fatal("watch not closed after its context was cancelled")
+			}
+		}
+	})
+}
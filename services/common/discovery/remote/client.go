@@ -0,0 +1,190 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/daffaromero/retries/services/common/discovery"
+)
+
+const (
+	requestTimeout  = 5 * time.Second
+	minRetryBackoff = 500 * time.Millisecond
+	maxRetryBackoff = 30 * time.Second
+)
+
+// Registry is a discovery.Registry backed by a Server.
+type Registry struct {
+	baseURL string
+	client  *http.Client
+}
+
+var _ discovery.Registry = (*Registry)(nil)
+
+// NewRegistry talks to the server at addr, either a URL or host:port.
+func NewRegistry(addr string) *Registry {
+	if !strings.Contains(addr, "://") {
+		addr = "http://" + addr
+	}
+	return &Registry{
+		baseURL: strings.TrimSuffix(addr, "/"),
+		client:  &http.Client{},
+	}
+}
+
+func instancePath(serviceName, instanceID string) string {
+	return "/v1/services/" + url.PathEscape(serviceName) + "/instances/" + url.PathEscape(instanceID)
+}
+
+func (r *Registry) Register(ctx context.Context, instance discovery.ServiceInstance) error {
+	if err := instance.Validate(); err != nil {
+		return err
+	}
+	body, err := json.Marshal(instance)
+	if err != nil {
+		return err
+	}
+	return r.do(ctx, http.MethodPut, instancePath(instance.Name, instance.ID), body, nil)
+}
+
+func (r *Registry) Deregister(ctx context.Context, instanceID, serviceName string) error {
+	return r.do(ctx, http.MethodDelete, instancePath(serviceName, instanceID), nil, nil)
+}
+
+func (r *Registry) HealthCheck(instanceID, serviceName string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+	return r.do(ctx, http.MethodPut, instancePath(serviceName, instanceID)+"/heartbeat", nil, nil)
+}
+
+func (r *Registry) Discover(ctx context.Context, serviceName string) ([]discovery.ServiceInstance, error) {
+	instances := []discovery.ServiceInstance{}
+	if err := r.do(ctx, http.MethodGet, "/v1/services/"+url.PathEscape(serviceName), nil, &instances); err != nil {
+		return nil, err
+	}
+	return instances, nil
+}
+
+// Watch follows the server's change stream, reconnecting with backoff when
+// it breaks.
+func (r *Registry) Watch(ctx context.Context, serviceName string) (<-chan []discovery.ServiceInstance, error) {
+	ch := make(chan []discovery.ServiceInstance)
+
+	go func() {
+		defer close(ch)
+
+		var (
+			last    []discovery.ServiceInstance
+			sent    bool
+			backoff = minRetryBackoff
+		)
+		for {
+			err := r.stream(ctx, serviceName, func(instances []discovery.ServiceInstance) bool {
+				backoff = minRetryBackoff
+				if sent && discovery.SameInstances(last, instances) {
+					return true
+				}
+				select {
+				case ch <- instances:
+					last, sent = instances, true
+					return true
+				case <-ctx.Done():
+					return false
+				}
+			})
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("Watch of %s ended, reconnecting in %s: %v", serviceName, backoff, err)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			backoff = min(backoff*2, maxRetryBackoff)
+		}
+	}()
+
+	return ch, nil
+}
+
+// stream reads one watch response, calling fn for every update until fn
+// returns false or the stream ends.
+func (r *Registry) stream(ctx context.Context, serviceName string, fn func([]discovery.ServiceInstance) bool) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.baseURL+"/v1/services/"+url.PathEscape(serviceName)+"/watch", nil)
+	if err != nil {
+		return err
+	}
+	res, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return responseError(res)
+	}
+
+	dec := json.NewDecoder(res.Body)
+	for {
+		var instances []discovery.ServiceInstance
+		if err := dec.Decode(&instances); err != nil {
+			return err
+		}
+		if instances == nil {
+			instances = []discovery.ServiceInstance{}
+		}
+		if !fn(instances) {
+			return nil
+		}
+	}
+}
+
+func (r *Registry) do(ctx context.Context, method, path string, body []byte, out any) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, requestTimeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, r.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	res, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		return responseError(res)
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+// responseError turns an error response written by utils.WriteError back
+// into an error.
+func responseError(res *http.Response) error {
+	var body struct {
+		Error string `json:"error"`
+	}
+	data, _ := io.ReadAll(io.LimitReader(res.Body, 4096))
+	if json.Unmarshal(data, &body) == nil && body.Error != "" {
+		return fmt.Errorf("registry: %s", body.Error)
+	}
+	return fmt.Errorf("registry: unexpected status %s", res.Status)
+}
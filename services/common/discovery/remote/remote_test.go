@@ -0,0 +1,26 @@
+package remote_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/daffaromero/retries/services/common/discovery"
+	"github.com/daffaromero/retries/services/common/discovery/memory"
+	"github.com/daffaromero/retries/services/common/discovery/registrytest"
+	"github.com/daffaromero/retries/services/common/discovery/remote"
+)
+
+func TestConformance(t *testing.T) {
+	registrytest.Run(t, func(t *testing.T) discovery.Registry {
+		local := memory.NewRegistry()
+		mux := http.NewServeMux()
+		remote.NewServer(local).Route(mux)
+		srv := httptest.NewServer(mux)
+		t.Cleanup(func() {
+			srv.Close()
+			local.Close()
+		})
+		return remote.NewRegistry(srv.URL)
+	})
+}
@@ -0,0 +1,110 @@
+// Package remote shares a memory registry between processes over HTTP, so
+// the services can find each other locally without a Consul agent. Server
+// exposes a memory.Registry and Registry is the discovery.Registry that
+// talks to it.
+//
+//	PUT    /v1/services/{service}/instances/{id}            register
+//	DELETE /v1/services/{service}/instances/{id}            deregister
+//	PUT    /v1/services/{service}/instances/{id}/heartbeat  heartbeat
+//	GET    /v1/services/{service}                           healthy instances
+//	GET    /v1/services/{service}/watch                     stream of changes
+//	GET    /v1/services                                     every instance
+package remote
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/daffaromero/retries/services/common/discovery"
+	"github.com/daffaromero/retries/services/common/discovery/memory"
+	"github.com/daffaromero/retries/services/common/utils"
+)
+
+type Server struct {
+	registry *memory.Registry
+}
+
+func NewServer(registry *memory.Registry) *Server {
+	return &Server{registry: registry}
+}
+
+func (s *Server) Route(mux *http.ServeMux) {
+	mux.HandleFunc("PUT /v1/services/{service}/instances/{id}", s.register)
+	mux.HandleFunc("DELETE /v1/services/{service}/instances/{id}", s.deregister)
+	mux.HandleFunc("PUT /v1/services/{service}/instances/{id}/heartbeat", s.heartbeat)
+	mux.HandleFunc("GET /v1/services/{service}", s.discover)
+	mux.HandleFunc("GET /v1/services/{service}/watch", s.watch)
+	mux.HandleFunc("GET /v1/services", s.list)
+}
+
+func (s *Server) register(w http.ResponseWriter, r *http.Request) {
+	var instance discovery.ServiceInstance
+	if err := utils.ParseJSON(r, &instance); err != nil {
+		utils.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+	if instance.ID != r.PathValue("id") || instance.Name != r.PathValue("service") {
+		utils.WriteError(w, http.StatusBadRequest, errors.New("instance does not match its path"))
+		return
+	}
+	if err := s.registry.Register(r.Context(), instance); err != nil {
+		utils.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) deregister(w http.ResponseWriter, r *http.Request) {
+	if err := s.registry.Deregister(r.Context(), r.PathValue("id"), r.PathValue("service")); err != nil {
+		utils.WriteError(w, http.StatusInternalServerError, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) heartbeat(w http.ResponseWriter, r *http.Request) {
+	if err := s.registry.HealthCheck(r.PathValue("id"), r.PathValue("service")); err != nil {
+		utils.WriteError(w, http.StatusNotFound, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) discover(w http.ResponseWriter, r *http.Request) {
+	instances, err := s.registry.Discover(r.Context(), r.PathValue("service"))
+	if err != nil {
+		utils.WriteError(w, http.StatusInternalServerError, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, instances)
+}
+
+// watch streams the instances of a service as newline-delimited JSON, one
+// line per change, until the client goes away.
+func (s *Server) watch(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		utils.WriteError(w, http.StatusInternalServerError, errors.New("streaming is not supported"))
+		return
+	}
+	updates, err := s.registry.Watch(r.Context(), r.PathValue("service"))
+	if err != nil {
+		utils.WriteError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	enc := json.NewEncoder(w)
+	for instances := range updates {
+		if err := enc.Encode(instances); err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request) {
+	utils.WriteJSON(w, http.StatusOK, s.registry.List())
+}
@@ -0,0 +1,137 @@
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/attributes"
+	"google.golang.org/grpc/resolver"
+)
+
+// Scheme is the target scheme of connections resolved through a Registry,
+// as in "registry:///product-service-grpc".
+const Scheme = "registry"
+
+// rewatchDelay is how long the resolver waits before watching a service
+// again after the registry ended or refused a watch.
+const rewatchDelay = 5 * time.Second
+
+type weightKey struct{}
+
+// Weight returns the weight of addr, taken from a "weight=N" registry tag.
+// Instances without one weigh 1.
+func Weight(addr resolver.Address) int {
+	if w, ok := addr.Attributes.Value(weightKey{}).(int); ok && w > 0 {
+		return w
+	}
+	return 1
+}
+
+func weightFromTags(tags []string) int {
+	for _, tag := range tags {
+		v, ok := strings.CutPrefix(tag, "weight=")
+		if !ok {
+			continue
+		}
+		if w, err := strconv.Atoi(v); err == nil && w > 0 {
+			return w
+		}
+	}
+	return 1
+}
+
+type resolverBuilder struct {
+	registry Registry
+}
+
+// NewResolverBuilder returns a resolver that keeps a ClientConn's addresses
+// in step with the healthy instances registry knows of, as reported by
+// Registry.Watch.
+func NewResolverBuilder(registry Registry) resolver.Builder {
+	return &resolverBuilder{registry: registry}
+}
+
+func (b *resolverBuilder) Scheme() string {
+	return Scheme
+}
+
+func (b *resolverBuilder) Build(target resolver.Target, cc resolver.ClientConn, opts resolver.BuildOptions) (resolver.Resolver, error) {
+	service := target.Endpoint()
+	if service == "" {
+		return nil, fmt.Errorf("no service name in target %q", target.URL.String())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &registryResolver{
+		registry: b.registry,
+		service:  service,
+		cc:       cc,
+		cancel:   cancel,
+	}
+	go r.watch(ctx)
+	return r, nil
+}
+
+type registryResolver struct {
+	registry Registry
+	service  string
+	cc       resolver.ClientConn
+	cancel   context.CancelFunc
+}
+
+// ResolveNow does nothing: the registry pushes every change already.
+func (r *registryResolver) ResolveNow(resolver.ResolveNowOptions) {}
+
+func (r *registryResolver) Close() {
+	r.cancel()
+}
+
+// watch follows the service for as long as the resolver is open, starting a
+// new watch after rewatchDelay if the registry ends one.
+func (r *registryResolver) watch(ctx context.Context) {
+	for {
+		updates, err := r.registry.Watch(ctx, r.service)
+		if err != nil {
+			r.cc.ReportError(fmt.Errorf("failed to watch %s: %w", r.service, err))
+		} else {
+			for instances := range updates {
+				r.update(instances)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(rewatchDelay):
+		}
+	}
+}
+
+func (r *registryResolver) update(instances []ServiceInstance) {
+	if len(instances) == 0 {
+		r.cc.ReportError(fmt.Errorf("no instances of %s available", r.service))
+		return
+	}
+
+	addrs := make([]resolver.Address, 0, len(instances))
+	for _, inst := range instances {
+		addrs = append(addrs, resolver.Address{
+			Addr:       inst.HostPort(),
+			Attributes: attributes.New(weightKey{}, weightFromTags(inst.Tags)),
+		})
+	}
+	slices.SortFunc(addrs, func(a, b resolver.Address) int {
+		return strings.Compare(a.Addr, b.Addr)
+	})
+
+	if err := r.cc.UpdateState(resolver.State{Addresses: addrs}); err != nil {
+		log.Printf("Failed to update addresses of %s: %v", r.service, err)
+		return
+	}
+	log.Printf("Resolved %d instances of %s", len(addrs), r.service)
+}
@@ -0,0 +1,239 @@
+// Package health reports whether a service can take traffic, over the
+// standard grpc.health.v1 protocol and as HTTP liveness and readiness probes.
+// A Checker runs the service's dependency checks in the background. An
+// instance with a failing dependency reports NOT_SERVING and stops its
+// registry heartbeats, so it is taken out of rotation until it recovers.
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/daffaromero/retries/services/common/discovery"
+	"github.com/daffaromero/retries/services/common/utils"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	checkInterval = 2 * time.Second
+	checkTimeout  = 2 * time.Second
+)
+
+// Check reports why a dependency is unusable, or nil if it is fine.
+type Check func(ctx context.Context) error
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Checker tracks the health of one service.
+type Checker struct {
+	service string
+	server  *health.Server
+
+	mu           sync.RWMutex
+	checks       []namedCheck
+	registration *discovery.Registration
+	results      map[string]error
+	checked      bool
+	shutdown     bool
+}
+
+// NewChecker returns a checker for service that is not ready until its
+// checks have run once.
+func NewChecker(service string) *Checker {
+	c := &Checker{
+		service: service,
+		server:  health.NewServer(),
+		results: map[string]error{},
+	}
+	c.setServing(false)
+	return c
+}
+
+// Add registers a dependency check, such as a database ping.
+func (c *Checker) Add(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks = append(c.checks, namedCheck{name: name, check: check})
+}
+
+// Track makes the instance's registration part of readiness and holds its
+// heartbeats back while a dependency check fails.
+func (c *Checker) Track(reg *discovery.Registration) {
+	c.mu.Lock()
+	c.registration = reg
+	c.mu.Unlock()
+	reg.ReadyWhen(c.dependencies)
+}
+
+// GRPC returns the grpc.health.v1 server to register on the gRPC server.
+func (c *Checker) GRPC() healthpb.HealthServer {
+	return c.server
+}
+
+// Run checks every dependency now and then every checkInterval until ctx is
+// done.
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	for {
+		c.update(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// update runs the checks, remembers their results and publishes the overall
+// status to the gRPC health server.
+func (c *Checker) update(ctx context.Context) {
+	results := c.run(ctx)
+
+	c.mu.Lock()
+	for name, err := range results {
+		if err != nil && c.results[name] == nil {
+			log.Printf("Health check %s failed: %v", name, err)
+		}
+		if err == nil && c.results[name] != nil {
+			log.Printf("Health check %s recovered", name)
+		}
+	}
+	c.results = results
+	c.checked = true
+	c.mu.Unlock()
+
+	c.setServing(c.ready() == nil)
+}
+
+// run runs every check concurrently, each within checkTimeout.
+func (c *Checker) run(ctx context.Context) map[string]error {
+	c.mu.RLock()
+	checks := append([]namedCheck(nil), c.checks...)
+	if c.registration != nil {
+		reg := c.registration
+		checks = append(checks, namedCheck{name: "registry", check: func(context.Context) error {
+			return reg.Err()
+		}})
+	}
+	c.mu.RUnlock()
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results = make(map[string]error, len(checks))
+	)
+	for _, nc := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+			defer cancel()
+			err := nc.check(ctx)
+			mu.Lock()
+			results[nc.name] = err
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// dependencies reports the first failing dependency check of the last run,
+// leaving the registration out since it depends on the heartbeats it gates.
+func (c *Checker) dependencies() error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.failing(false)
+}
+
+// ready reports the first failing check of the last run.
+func (c *Checker) ready() error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.failing(true)
+}
+
+// failing must be called with c.mu held.
+func (c *Checker) failing(withRegistry bool) error {
+	if c.shutdown {
+		return errors.New("shutting down")
+	}
+	if !c.checked {
+		return errors.New("not checked yet")
+	}
+	var errs []error
+	for name, err := range c.results {
+		if err == nil || (name == "registry" && !withRegistry) {
+			continue
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", name, err))
+	}
+	return errors.Join(errs...)
+}
+
+// Shutdown reports the service as not ready from now on, so load balancers
+// and probes stop sending it traffic while it drains.
+func (c *Checker) Shutdown(ctx context.Context) error {
+	c.mu.Lock()
+	c.shutdown = true
+	c.mu.Unlock()
+	c.server.Shutdown()
+	return nil
+}
+
+func (c *Checker) setServing(serving bool) {
+	status := healthpb.HealthCheckResponse_NOT_SERVING
+	if serving {
+		status = healthpb.HealthCheckResponse_SERVING
+	}
+	c.server.SetServingStatus("", status)
+	c.server.SetServingStatus(c.service, status)
+}
+
+type report struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// LiveHandler answers liveness probes: the process is up and serving HTTP.
+func (c *Checker) LiveHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		utils.WriteJSON(w, http.StatusOK, report{Status: "ok"})
+	}
+}
+
+// ReadyHandler answers readiness probes by running every check, so the
+// answer reflects the dependencies at the time of the probe.
+func (c *Checker) ReadyHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		c.update(r.Context())
+
+		c.mu.RLock()
+		res := report{Status: "ready", Checks: make(map[string]string, len(c.results))}
+		for name, err := range c.results {
+			res.Checks[name] = "ok"
+			if err != nil {
+				res.Checks[name] = err.Error()
+			}
+		}
+		c.mu.RUnlock()
+
+		status := http.StatusOK
+		if c.ready() != nil {
+			res.Status = "not ready"
+			status = http.StatusServiceUnavailable
+		}
+		utils.WriteJSON(w, status, res)
+	}
+}
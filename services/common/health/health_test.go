@@ -0,0 +1,148 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/daffaromero/retries/services/common/discovery"
+	"github.com/daffaromero/retries/services/common/discovery/memory"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// switchable is a check whose result the test sets.
+type switchable struct {
+	err atomic.Pointer[error]
+}
+
+func (s *switchable) set(err error) { s.err.Store(&err) }
+
+func (s *switchable) check(context.Context) error {
+	if err := s.err.Load(); err != nil {
+		return *err
+	}
+	return nil
+}
+
+func wantServing(t *testing.T, c *Checker, want healthpb.HealthCheckResponse_ServingStatus) {
+	t.Helper()
+	for _, service := range []string{"", c.service} {
+		res, err := c.GRPC().Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Status != want {
+			t.Errorf("status of %q = %s, want %s", service, res.Status, want)
+		}
+	}
+}
+
+func TestCheckerStatus(t *testing.T) {
+	db := &switchable{}
+	c := NewChecker("svc")
+	c.Add("db", db.check)
+	wantServing(t, c, healthpb.HealthCheckResponse_NOT_SERVING)
+
+	c.update(context.Background())
+	wantServing(t, c, healthpb.HealthCheckResponse_SERVING)
+
+	db.set(errors.New("connection refused"))
+	c.update(context.Background())
+	wantServing(t, c, healthpb.HealthCheckResponse_NOT_SERVING)
+
+	db.set(nil)
+	c.update(context.Background())
+	wantServing(t, c, healthpb.HealthCheckResponse_SERVING)
+
+	if err := c.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	c.update(context.Background())
+	wantServing(t, c, healthpb.HealthCheckResponse_NOT_SERVING)
+}
+
+func TestReadyHandler(t *testing.T) {
+	db := &switchable{}
+	c := NewChecker("svc")
+	c.Add("db", db.check)
+
+	probe := func() (int, report) {
+		rec := httptest.NewRecorder()
+		c.ReadyHandler()(rec, httptest.NewRequest(http.MethodGet, "/ready", nil))
+		var res report
+		if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+			t.Fatal(err)
+		}
+		return rec.Code, res
+	}
+
+	if code, res := probe(); code != http.StatusOK || res.Checks["db"] != "ok" {
+		t.Errorf("healthy probe = %d %+v", code, res)
+	}
+
+	db.set(errors.New("connection refused"))
+	if code, res := probe(); code != http.StatusServiceUnavailable || res.Status != "not ready" || res.Checks["db"] != "connection refused" {
+		t.Errorf("failing probe = %d %+v", code, res)
+	}
+
+	rec := httptest.NewRecorder()
+	c.LiveHandler()(rec, httptest.NewRequest(http.MethodGet, "/live", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("live probe with a failing dependency = %d, want 200", rec.Code)
+	}
+}
+
+// countingRegistry counts the heartbeats it receives.
+type countingRegistry struct {
+	*memory.Registry
+	beats atomic.Int32
+}
+
+func (r *countingRegistry) HealthCheck(instanceID, serviceName string) error {
+	r.beats.Add(1)
+	return r.Registry.HealthCheck(instanceID, serviceName)
+}
+
+func TestTrackHoldsHeartbeats(t *testing.T) {
+	registry := &countingRegistry{Registry: memory.NewRegistry()}
+	defer registry.Close()
+
+	reg, err := discovery.Register(context.Background(), registry, "svc", "127.0.0.1:9000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reg.Close()
+
+	db := &switchable{}
+	db.set(errors.New("connection refused"))
+	c := NewChecker("svc")
+	c.Add("db", db.check)
+	c.Track(reg)
+	c.update(context.Background())
+
+	time.Sleep(1500 * time.Millisecond)
+	if n := registry.beats.Load(); n != 0 {
+		t.Errorf("%d heartbeats sent while a dependency failed, want none", n)
+	}
+	// Held back heartbeats are not a registry failure.
+	if err := c.dependencies(); err == nil {
+		t.Error("dependencies reports ready with db failing")
+	}
+	if err := reg.Err(); err != nil {
+		t.Errorf("registration error = %v", err)
+	}
+
+	db.set(nil)
+	c.update(context.Background())
+	time.Sleep(1500 * time.Millisecond)
+	if registry.beats.Load() == 0 {
+		t.Error("no heartbeats after the dependency recovered")
+	}
+	c.update(context.Background())
+	wantServing(t, c, healthpb.HealthCheckResponse_SERVING)
+}
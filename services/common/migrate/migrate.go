@@ -0,0 +1,302 @@
+// Package migrate applies the numbered SQL migrations a service embeds and
+// records the schema version they leave the database at, so a service can
+// refuse to run against a schema it was not built for.
+//
+// Migrations are files named NNNNNN_name.up.sql and NNNNNN_name.down.sql.
+// Each one runs in its own transaction together with the version update,
+// and the whole run holds a Postgres advisory lock, so instances starting
+// together apply every migration exactly once. Versions are kept per
+// service in the schema_versions table, so services may share a database.
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io/fs"
+	"log"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ErrIncompatible is returned by Check when the database schema is not at
+// the version the service was built for.
+var ErrIncompatible = errors.New("incompatible database schema")
+
+var fileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// Migration is one schema change.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	// Down reverts Up. An empty Down can not be reverted.
+	Down string
+}
+
+// Migrator applies a service's migrations to a database.
+type Migrator struct {
+	db         *pgxpool.Pool
+	service    string
+	migrations []Migration
+}
+
+// New reads the migrations in the root of fsys for service. Every version
+// needs an up file; down files are optional.
+func New(db *pgxpool.Pool, service string, fsys fs.FS) (*Migrator, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	byVersion := map[int64]*Migration{}
+	for _, entry := range entries {
+		m := fileName.FindStringSubmatch(entry.Name())
+		if m == nil {
+			continue
+		}
+		version, err := strconv.ParseInt(m[1], 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("migration %s: bad version", entry.Name())
+		}
+		sql, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+
+		mig, ok := byVersion[version]
+		if !ok {
+			mig = &Migration{Version: version, Name: m[2]}
+			byVersion[version] = mig
+		} else if mig.Name != m[2] {
+			return nil, fmt.Errorf("migration %d is named both %s and %s", version, mig.Name, m[2])
+		}
+		if m[3] == "up" {
+			mig.Up = string(sql)
+		} else {
+			mig.Down = string(sql)
+		}
+	}
+
+	migrator := &Migrator{db: db, service: service}
+	for _, mig := range byVersion {
+		if mig.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up file", mig.Version, mig.Name)
+		}
+		migrator.migrations = append(migrator.migrations, *mig)
+	}
+	slices.SortFunc(migrator.migrations, func(a, b Migration) int {
+		return int(a.Version - b.Version)
+	})
+	return migrator, nil
+}
+
+// Latest returns the version the newest migration leaves the schema at.
+func (m *Migrator) Latest() int64 {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Version returns the schema version recorded for the service, 0 if none
+// is.
+func (m *Migrator) Version(ctx context.Context) (int64, error) {
+	return version(ctx, m.db, m.service)
+}
+
+// Check returns ErrIncompatible unless the database schema is at Latest.
+func (m *Migrator) Check(ctx context.Context) error {
+	current, err := m.Version(ctx)
+	if err != nil {
+		return err
+	}
+	switch latest := m.Latest(); {
+	case current < latest:
+		return fmt.Errorf("%w: %s needs schema version %d but the database is at %d; run migrations first", ErrIncompatible, m.service, latest, current)
+	case current > latest:
+		return fmt.Errorf("%w: the database is at schema version %d, newer than the %d this build of %s knows", ErrIncompatible, current, latest, m.service)
+	}
+	return nil
+}
+
+// Up applies every migration newer than the recorded version.
+func (m *Migrator) Up(ctx context.Context) error {
+	return m.locked(ctx, func(conn *pgxpool.Conn) error {
+		current, err := version(ctx, conn, m.service)
+		if err != nil {
+			return err
+		}
+		for _, mig := range m.migrations {
+			if mig.Version <= current {
+				continue
+			}
+			if err := m.apply(ctx, conn, mig.Up, mig.Version); err != nil {
+				return fmt.Errorf("migration %d_%s failed: %w", mig.Version, mig.Name, err)
+			}
+			log.Printf("Applied migration %d_%s to %s", mig.Version, mig.Name, m.service)
+		}
+		return nil
+	})
+}
+
+// Down reverts the newest steps applied migrations.
+func (m *Migrator) Down(ctx context.Context, steps int) error {
+	return m.locked(ctx, func(conn *pgxpool.Conn) error {
+		current, err := version(ctx, conn, m.service)
+		if err != nil {
+			return err
+		}
+		for ; steps > 0 && current > 0; steps-- {
+			i := slices.IndexFunc(m.migrations, func(mig Migration) bool { return mig.Version == current })
+			if i < 0 {
+				return fmt.Errorf("the database is at schema version %d, which %s does not know", current, m.service)
+			}
+			mig := m.migrations[i]
+			if mig.Down == "" {
+				return fmt.Errorf("migration %d_%s can not be reverted", mig.Version, mig.Name)
+			}
+
+			var previous int64
+			if i > 0 {
+				previous = m.migrations[i-1].Version
+			}
+			if err := m.apply(ctx, conn, mig.Down, previous); err != nil {
+				return fmt.Errorf("reverting migration %d_%s failed: %w", mig.Version, mig.Name, err)
+			}
+			log.Printf("Reverted migration %d_%s from %s", mig.Version, mig.Name, m.service)
+			current = previous
+		}
+		return nil
+	})
+}
+
+// Force records version as the schema version without running anything,
+// for databases whose schema was created by other means.
+func (m *Migrator) Force(ctx context.Context, version int64) error {
+	return m.locked(ctx, func(conn *pgxpool.Conn) error {
+		return m.apply(ctx, conn, "", version)
+	})
+}
+
+// Run carries out a migrate subcommand given as args:
+//
+//	up          apply every pending migration
+//	down [n]    revert the newest n migrations, 1 by default
+//	version     print the schema version
+//	force N     record N as the schema version without running anything
+func (m *Migrator) Run(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: migrate up | down [n] | version | force N")
+	}
+	switch cmd, rest := args[0], args[1:]; cmd {
+	case "up":
+		if err := m.Up(ctx); err != nil {
+			return err
+		}
+	case "down":
+		steps := 1
+		if len(rest) > 0 {
+			n, err := strconv.Atoi(rest[0])
+			if err != nil || n <= 0 {
+				return fmt.Errorf("migrate down: bad step count %q", rest[0])
+			}
+			steps = n
+		}
+		if err := m.Down(ctx, steps); err != nil {
+			return err
+		}
+	case "version":
+	case "force":
+		if len(rest) != 1 {
+			return errors.New("usage: migrate force N")
+		}
+		v, err := strconv.ParseInt(rest[0], 10, 64)
+		if err != nil || v < 0 {
+			return fmt.Errorf("migrate force: bad version %q", rest[0])
+		}
+		if err := m.Force(ctx, v); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown migrate command %q; want up, down, version or force", cmd)
+	}
+
+	current, err := m.Version(ctx)
+	if err != nil {
+		return err
+	}
+	log.Printf("%s schema is at version %d of %d", m.service, current, m.Latest())
+	return nil
+}
+
+// apply runs sql and records version in one transaction.
+func (m *Migrator) apply(ctx context.Context, conn *pgxpool.Conn, sql string, version int64) error {
+	return pgx.BeginFunc(ctx, conn, func(tx pgx.Tx) error {
+		if sql != "" {
+			if _, err := tx.Exec(ctx, sql); err != nil {
+				return err
+			}
+		}
+		_, err := tx.Exec(ctx, `INSERT INTO schema_versions (service, version, applied_at) VALUES ($1, $2, NOW())
+			ON CONFLICT (service) DO UPDATE SET version = EXCLUDED.version, applied_at = EXCLUDED.applied_at`, m.service, version)
+		return err
+	})
+}
+
+// locked runs fn on a connection holding the service's migration lock,
+// creating the schema_versions table first if needed.
+func (m *Migrator) locked(ctx context.Context, fn func(*pgxpool.Conn) error) error {
+	conn, err := m.db.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire a connection: %w", err)
+	}
+	defer conn.Release()
+
+	key := int64(crc32.ChecksumIEEE([]byte("schema_versions:" + m.service)))
+	if _, err := conn.Exec(ctx, "SELECT pg_advisory_lock($1)", key); err != nil {
+		return fmt.Errorf("failed to take the migration lock: %w", err)
+	}
+	defer func() {
+		// The lock is released with the session if this fails.
+		if _, err := conn.Exec(context.Background(), "SELECT pg_advisory_unlock($1)", key); err != nil {
+			conn.Conn().Close(context.Background())
+		}
+	}()
+
+	_, err = conn.Exec(ctx, `CREATE TABLE IF NOT EXISTS schema_versions (
+		service VARCHAR PRIMARY KEY,
+		version BIGINT NOT NULL,
+		applied_at TIMESTAMP NOT NULL DEFAULT NOW()
+	)`)
+	if err != nil {
+		return fmt.Errorf("failed to create schema_versions: %w", err)
+	}
+	return fn(conn)
+}
+
+type querier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+func version(ctx context.Context, db querier, service string) (int64, error) {
+	var v int64
+	err := db.QueryRow(ctx, "SELECT version FROM schema_versions WHERE service = $1", service).Scan(&v)
+	var pgErr *pgconn.PgError
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return 0, nil
+	case errors.As(err, &pgErr) && pgErr.Code == "42P01":
+		// schema_versions does not exist until the first migration.
+		return 0, nil
+	case err != nil:
+		return 0, fmt.Errorf("failed to read the schema version: %w", err)
+	}
+	return v, nil
+}
@@ -0,0 +1,65 @@
+package migrate_test
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+
+	"github.com/daffaromero/retries/services/common/migrate"
+)
+
+func file(sql string) *fstest.MapFile { return &fstest.MapFile{Data: []byte(sql)} }
+
+func TestNew(t *testing.T) {
+	m, err := migrate.New(nil, "test", fstest.MapFS{
+		"000010_add_index.up.sql":     file("CREATE INDEX i ON t (a);"),
+		"000002_create_t.up.sql":      file("CREATE TABLE t (a INT);"),
+		"000002_create_t.down.sql":    file("DROP TABLE t;"),
+		"000011_irreversible.up.sql":  file("UPDATE t SET a = 0;"),
+		"README.md":                   file("not a migration"),
+		"000012_no_direction.sql":     file("ignored"),
+		"migrations.go":               file("package migrations"),
+		"000013_only_down.down.sq":    file("ignored"),
+		"000001_create_schema.up.sql": file("CREATE SCHEMA s;"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := m.Latest(); got != 11 {
+		t.Errorf("Latest = %d, want 11", got)
+	}
+}
+
+func TestNewRejects(t *testing.T) {
+	for name, fsys := range map[string]fstest.MapFS{
+		"no up file": {
+			"000001_a.down.sql": file("DROP TABLE t;"),
+		},
+		"two names for a version": {
+			"000001_a.up.sql": file("CREATE TABLE a (x INT);"),
+			"000001_b.up.sql": file("CREATE TABLE b (x INT);"),
+		},
+		"version zero": {
+			"000000_a.up.sql": file("CREATE TABLE a (x INT);"),
+		},
+	} {
+		if _, err := migrate.New(nil, "test", fsys); err == nil {
+			t.Errorf("%s: New succeeded", name)
+		}
+	}
+}
+
+func TestRunRejectsBadArgs(t *testing.T) {
+	m, err := migrate.New(nil, "test", fstest.MapFS{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := m.Latest(); got != 0 {
+		t.Errorf("Latest with no migrations = %d, want 0", got)
+	}
+	for _, args := range [][]string{nil, {"sideways"}, {"down", "0"}, {"down", "x"}, {"force"}, {"force", "-1"}} {
+		if err := m.Run(context.Background(), args); err == nil {
+			t.Errorf("Run(%q) succeeded", args)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Package migratetest gives tests a database at a service's latest schema, so
+// queries are checked against the columns the migrations really create.
+package migratetest
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/daffaromero/retries/services/common/migrate"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var seq atomic.Int64
+
+// DB applies the migrations in fsys to a schema of its own on the Postgres
+// server at TEST_DATABASE_URL and returns a pool that uses it. The schema is
+// dropped when t ends. The test is skipped when TEST_DATABASE_URL is not set.
+func DB(t *testing.T, service string, fsys fs.FS) *pgxpool.Pool {
+	t.Helper()
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL not set")
+	}
+	ctx := context.Background()
+
+	admin, err := pgx.Connect(ctx, url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	schema := fmt.Sprintf("test_%d_%d", time.Now().UnixNano(), seq.Add(1))
+	if _, err := admin.Exec(ctx, "CREATE SCHEMA "+schema); err != nil {
+		admin.Close(ctx)
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if _, err := admin.Exec(ctx, "DROP SCHEMA "+schema+" CASCADE"); err != nil {
+			t.Errorf("failed to drop schema %s: %v", schema, err)
+		}
+		admin.Close(ctx)
+	})
+
+	conf, err := pgxpool.ParseConfig(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf.ConnConfig.RuntimeParams["search_path"] = schema
+	// Match the services, which describe every statement before running it.
+	conf.ConnConfig.DefaultQueryExecMode = pgx.QueryExecModeDescribeExec
+	pool, err := pgxpool.NewWithConfig(ctx, conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(pool.Close)
+
+	m, err := migrate.New(pool, service, fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Up(ctx); err != nil {
+		t.Fatalf("failed to migrate %s: %v", service, err)
+	}
+	return pool
+}
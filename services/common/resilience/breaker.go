@@ -0,0 +1,299 @@
+package resilience
+
+import (
+	"context"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type State string
+
+const (
+	StateClosed   State = "closed"
+	StateOpen     State = "open"
+	StateHalfOpen State = "half_open"
+)
+
+// breaker is the circuit breaker and bulkhead of one service method.
+type breaker struct {
+	service string
+	method  string
+	policy  Policy
+	slots   chan struct{}
+	// now is the breaker's clock, replaced in tests.
+	now func() time.Time
+
+	mu          sync.Mutex
+	state       State
+	windowStart time.Time
+	requests    int
+	failures    int
+	openedAt    time.Time
+	probes      int
+}
+
+func newBreaker(service, method string, p Policy) *breaker {
+	b := &breaker{
+		service:     service,
+		method:      method,
+		policy:      p,
+		state:       StateClosed,
+		windowStart: time.Now(),
+		now:         time.Now,
+	}
+	if p.MaxConcurrent > 0 {
+		b.slots = make(chan struct{}, p.MaxConcurrent)
+	}
+	return b
+}
+
+// acquire admits a call or rejects it with an UnavailableError. An admitted
+// call must be finished with done.
+func (b *breaker) acquire() (func(error), error) {
+	if err := b.allow(); err != nil {
+		return nil, err
+	}
+	if b.slots != nil {
+		select {
+		case b.slots <- struct{}{}:
+		default:
+			b.cancelProbe()
+			return nil, &UnavailableError{Service: b.service, Method: b.method, Reason: "too many calls in flight"}
+		}
+	}
+
+	var once sync.Once
+	return func(err error) {
+		once.Do(func() {
+			if b.slots != nil {
+				<-b.slots
+			}
+			b.record(err)
+		})
+	}, nil
+}
+
+func (b *breaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	switch b.state {
+	case StateOpen:
+		wait := time.Duration(b.policy.OpenDuration) - now.Sub(b.openedAt)
+		if wait > 0 {
+			return &UnavailableError{Service: b.service, Method: b.method, Reason: "circuit open", RetryAfter: wait}
+		}
+		b.state = StateHalfOpen
+		b.probes = 0
+		fallthrough
+	case StateHalfOpen:
+		if b.probes >= max(b.policy.HalfOpenRequests, 1) {
+			return &UnavailableError{Service: b.service, Method: b.method, Reason: "circuit half open"}
+		}
+		b.probes++
+	}
+	return nil
+}
+
+func (b *breaker) cancelProbe() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == StateHalfOpen && b.probes > 0 {
+		b.probes--
+	}
+}
+
+func (b *breaker) record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	failed := isFailure(err)
+	now := b.now()
+	switch b.state {
+	case StateHalfOpen:
+		if failed {
+			b.trip(now)
+			return
+		}
+		b.state = StateClosed
+		b.resetWindow(now)
+	case StateClosed:
+		if now.Sub(b.windowStart) > time.Duration(b.policy.Window) {
+			b.resetWindow(now)
+		}
+		b.requests++
+		if failed {
+			b.failures++
+		}
+		if b.requests >= b.policy.MinimumRequests && float64(b.failures) >= b.policy.FailureRate*float64(b.requests) && b.failures > 0 {
+			b.trip(now)
+		}
+	}
+}
+
+func (b *breaker) trip(now time.Time) {
+	b.state = StateOpen
+	b.openedAt = now
+	b.probes = 0
+	b.resetWindow(now)
+}
+
+func (b *breaker) resetWindow(now time.Time) {
+	b.windowStart = now
+	b.requests = 0
+	b.failures = 0
+}
+
+// isFailure reports whether err says the backend is unhealthy. Errors about
+// the request itself, such as NotFound, do not count against the circuit.
+func isFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
+
+// BreakerState is a breaker as shown by the debug endpoint.
+type BreakerState struct {
+	Service  string     `json:"service"`
+	Method   string     `json:"method"`
+	State    State      `json:"state"`
+	Requests int        `json:"requests"`
+	Failures int        `json:"failures"`
+	InFlight int        `json:"in_flight"`
+	OpenedAt *time.Time `json:"opened_at,omitempty"`
+}
+
+func (b *breaker) snapshot() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	st := BreakerState{
+		Service:  b.service,
+		Method:   b.method,
+		State:    b.state,
+		Requests: b.requests,
+		Failures: b.failures,
+		InFlight: len(b.slots),
+	}
+	if b.state == StateOpen && b.now().Sub(b.openedAt) >= time.Duration(b.policy.OpenDuration) {
+		st.State = StateHalfOpen
+	}
+	if st.State != StateClosed {
+		openedAt := b.openedAt
+		st.OpenedAt = &openedAt
+	}
+	return st
+}
+
+// breakers holds one breaker per service method for the whole process, so
+// every connection to a service shares its circuit.
+var breakers = struct {
+	sync.Mutex
+	m map[string]*breaker
+}{m: map[string]*breaker{}}
+
+func breakerFor(service, method string, cfg *Config) *breaker {
+	breakers.Lock()
+	defer breakers.Unlock()
+
+	key := service + method
+	b, ok := breakers.m[key]
+	if !ok {
+		b = newBreaker(service, method, cfg.Policy(service, method))
+		breakers.m[key] = b
+	}
+	return b
+}
+
+// Breakers returns the state of every breaker in the process.
+func Breakers() []BreakerState {
+	breakers.Lock()
+	list := make([]*breaker, 0, len(breakers.m))
+	for _, b := range breakers.m {
+		list = append(list, b)
+	}
+	breakers.Unlock()
+
+	states := make([]BreakerState, 0, len(list))
+	for _, b := range list {
+		states = append(states, b.snapshot())
+	}
+	sort.Slice(states, func(i, j int) bool {
+		if states[i].Service != states[j].Service {
+			return states[i].Service < states[j].Service
+		}
+		return states[i].Method < states[j].Method
+	})
+	return states
+}
+
+// BreakerUnaryClientInterceptor guards the unary calls a client of service
+// makes with a circuit breaker and bulkhead per method.
+func BreakerUnaryClientInterceptor(service string, cfg *Config) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		done, err := breakerFor(service, method, cfg).acquire()
+		if err != nil {
+			return err
+		}
+		err = invoker(ctx, method, req, reply, cc, opts...)
+		done(err)
+		return err
+	}
+}
+
+// BreakerStreamClientInterceptor does the same for streams. A stream holds
+// its slot until it ends or its context is done.
+func BreakerStreamClientInterceptor(service string, cfg *Config) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		done, err := breakerFor(service, method, cfg).acquire()
+		if err != nil {
+			return nil, err
+		}
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			done(err)
+			return nil, err
+		}
+		finished := make(chan struct{})
+		var once sync.Once
+		end := func(err error) {
+			once.Do(func() {
+				done(err)
+				close(finished)
+			})
+		}
+		go func() {
+			select {
+			case <-ctx.Done():
+				end(nil)
+			case <-finished:
+			}
+		}()
+		return &guardedStream{ClientStream: cs, done: end}, nil
+	}
+}
+
+type guardedStream struct {
+	grpc.ClientStream
+	done func(error)
+}
+
+func (s *guardedStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err == io.EOF {
+		s.done(nil)
+	} else if err != nil {
+		s.done(err)
+	}
+	return err
+}
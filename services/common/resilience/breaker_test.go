@@ -0,0 +1,225 @@
+package resilience
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	errDown     = status.Error(codes.Unavailable, "down")
+	errNotFound = status.Error(codes.NotFound, "no such thing")
+)
+
+type clock struct{ t time.Time }
+
+func (c *clock) now() time.Time          { return c.t }
+func (c *clock) advance(d time.Duration) { c.t = c.t.Add(d) }
+
+func testPolicy() Policy {
+	return Policy{
+		FailureRate:      0.5,
+		MinimumRequests:  4,
+		Window:           Duration(10 * time.Second),
+		OpenDuration:     Duration(5 * time.Second),
+		HalfOpenRequests: 1,
+	}
+}
+
+func newTestBreaker(p Policy) (*breaker, *clock) {
+	c := &clock{t: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	b := newBreaker("svc", "/pkg.Svc/Method", p)
+	b.now = c.now
+	b.windowStart = c.t
+	return b, c
+}
+
+// call runs one call through b that ends with err, failing the test if b
+// rejects it.
+func call(t *testing.T, b *breaker, err error) {
+	t.Helper()
+	done, rejected := b.acquire()
+	if rejected != nil {
+		t.Fatalf("call rejected: %v", rejected)
+	}
+	done(err)
+}
+
+func TestBreakerTrips(t *testing.T) {
+	tests := []struct {
+		name    string
+		results []error
+		want    State
+	}{
+		{"below minimum requests", []error{errDown, errDown, errDown}, StateClosed},
+		{"below failure rate", []error{nil, nil, nil, errDown}, StateClosed},
+		{"at failure rate", []error{nil, nil, errDown, errDown}, StateOpen},
+		{"all failing", []error{errDown, errDown, errDown, errDown}, StateOpen},
+		{"client errors do not count", []error{errNotFound, errNotFound, errNotFound, errNotFound}, StateClosed},
+		{"all succeeding", []error{nil, nil, nil, nil, nil}, StateClosed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, _ := newTestBreaker(testPolicy())
+			for _, err := range tt.results {
+				call(t, b, err)
+			}
+			if got := b.snapshot().State; got != tt.want {
+				t.Errorf("state = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBreakerWindowExpires(t *testing.T) {
+	b, c := newTestBreaker(testPolicy())
+	for i := 0; i < 3; i++ {
+		call(t, b, errDown)
+	}
+	c.advance(11 * time.Second)
+	call(t, b, errDown)
+
+	st := b.snapshot()
+	if st.State != StateClosed || st.Requests != 1 {
+		t.Errorf("after the window: %s with %d requests, want closed with 1", st.State, st.Requests)
+	}
+}
+
+func TestBreakerOpenRejects(t *testing.T) {
+	b, c := newTestBreaker(testPolicy())
+	for i := 0; i < 4; i++ {
+		call(t, b, errDown)
+	}
+
+	c.advance(2 * time.Second)
+	_, err := b.acquire()
+	var ue *UnavailableError
+	if !errors.As(err, &ue) || !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("acquire on an open circuit = %v, want an UnavailableError", err)
+	}
+	if ue.RetryAfter != 3*time.Second {
+		t.Errorf("RetryAfter = %v, want 3s", ue.RetryAfter)
+	}
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("code = %s, want Unavailable", status.Code(err))
+	}
+}
+
+func TestBreakerHalfOpen(t *testing.T) {
+	tests := []struct {
+		name  string
+		probe error
+		want  State
+	}{
+		{"probe succeeds", nil, StateClosed},
+		{"probe fails", errDown, StateOpen},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := testPolicy()
+			p.HalfOpenRequests = 2
+			b, c := newTestBreaker(p)
+			for i := 0; i < 4; i++ {
+				call(t, b, errDown)
+			}
+
+			c.advance(5 * time.Second)
+			if got := b.snapshot().State; got != StateHalfOpen {
+				t.Fatalf("state after OpenDuration = %s, want half_open", got)
+			}
+			first, err := b.acquire()
+			if err != nil {
+				t.Fatalf("first probe rejected: %v", err)
+			}
+			second, err := b.acquire()
+			if err != nil {
+				t.Fatalf("second probe rejected: %v", err)
+			}
+			if _, err := b.acquire(); !errors.Is(err, ErrUnavailable) {
+				t.Fatalf("probe beyond HalfOpenRequests = %v, want rejected", err)
+			}
+
+			first(tt.probe)
+			if got := b.snapshot().State; got != tt.want {
+				t.Errorf("state after probe = %s, want %s", got, tt.want)
+			}
+			second(nil)
+
+			if tt.want == StateOpen {
+				if _, err := b.acquire(); !errors.Is(err, ErrUnavailable) {
+					t.Errorf("acquire after a failed probe = %v, want rejected", err)
+				}
+				return
+			}
+			call(t, b, nil)
+		})
+	}
+}
+
+func TestBulkhead(t *testing.T) {
+	p := testPolicy()
+	p.MaxConcurrent = 2
+	b, _ := newTestBreaker(p)
+
+	first, err := b.acquire()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.acquire(); err != nil {
+		t.Fatal(err)
+	}
+	_, err = b.acquire()
+	var ue *UnavailableError
+	if !errors.As(err, &ue) || ue.Reason != "too many calls in flight" {
+		t.Fatalf("call beyond MaxConcurrent = %v, want rejected by the bulkhead", err)
+	}
+	if got := b.snapshot().InFlight; got != 2 {
+		t.Errorf("in flight = %d, want 2", got)
+	}
+
+	first(nil)
+	first(nil) // done is idempotent and must not free a second slot
+	if got := b.snapshot().InFlight; got != 1 {
+		t.Errorf("in flight after one call ended = %d, want 1", got)
+	}
+	if _, err := b.acquire(); err != nil {
+		t.Errorf("call after a slot freed = %v", err)
+	}
+}
+
+func TestBulkheadReturnsHalfOpenProbe(t *testing.T) {
+	p := testPolicy()
+	p.HalfOpenRequests = 2
+	p.MaxConcurrent = 1
+	b, c := newTestBreaker(p)
+	for i := 0; i < 4; i++ {
+		call(t, b, errDown)
+	}
+	c.advance(5 * time.Second)
+
+	probe, err := b.acquire()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The breaker admits a second probe, but the bulkhead is full; the probe
+	// must be handed back rather than used up.
+	_, err = b.acquire()
+	var ue *UnavailableError
+	if !errors.As(err, &ue) || ue.Reason != "too many calls in flight" {
+		t.Fatalf("second call = %v, want rejected by the bulkhead", err)
+	}
+	b.mu.Lock()
+	probes := b.probes
+	b.mu.Unlock()
+	if probes != 1 {
+		t.Errorf("probes in use = %d, want 1", probes)
+	}
+
+	probe(nil)
+	if got := b.snapshot().State; got != StateClosed {
+		t.Errorf("state = %s, want closed", got)
+	}
+}
@@ -0,0 +1,340 @@
+// Package resilience bounds and retries the gRPC calls services make to each
+// other. Policies are declared per service and method, for example:
+//
+//	{
+//	  "default": {"initial_backoff": "100ms", "timeout": "5s"},
+//	  "load_balancing": "round_robin",
+//	  "services": {
+//	    "product-service-grpc": {
+//	      "load_balancing": "least_request",
+//	      "methods": {
+//	        "GetProductByID": {"max_attempts": 3, "hedging_delay": "50ms", "timeout": "1s"}
+//	      }
+//	    }
+//	  }
+//	}
+//
+// Calls run once unless their method opts in to more attempts: a retried or
+// hedged call may reach the server twice, which only idempotent methods can
+// afford. The read methods in IdempotentMethods opt in by default.
+package resilience
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/daffaromero/retries/services/common/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Duration is a time.Duration written as a string such as "250ms" in config
+// files.
+type Duration time.Duration
+
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("duration must be a string: %w", err)
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// Policy describes how calls to one method are retried, hedged and bounded.
+// Zero fields inherit from the enclosing level of the Config.
+type Policy struct {
+	// MaxAttempts includes the first attempt; 1 disables retries and hedging.
+	MaxAttempts       int      `json:"max_attempts,omitempty"`
+	InitialBackoff    Duration `json:"initial_backoff,omitempty"`
+	MaxBackoff        Duration `json:"max_backoff,omitempty"`
+	BackoffMultiplier float64  `json:"backoff_multiplier,omitempty"`
+	// Jitter spreads each backoff by up to this fraction either way.
+	Jitter         float64  `json:"jitter,omitempty"`
+	RetryableCodes []string `json:"retryable_status_codes,omitempty"`
+	// HedgingDelay, when set, sends another attempt every delay until one
+	// succeeds instead of waiting for each attempt to fail.
+	HedgingDelay Duration `json:"hedging_delay,omitempty"`
+	// Timeout is the deadline of the whole call, attempts included.
+	Timeout Duration `json:"timeout,omitempty"`
+
+	// The circuit opens once FailureRate of at least MinimumRequests calls
+	// within Window have failed, rejects calls for OpenDuration, then lets
+	// HalfOpenRequests probes through to decide whether to close again.
+	FailureRate      float64  `json:"failure_rate,omitempty"`
+	MinimumRequests  int      `json:"minimum_requests,omitempty"`
+	Window           Duration `json:"window,omitempty"`
+	OpenDuration     Duration `json:"open_duration,omitempty"`
+	HalfOpenRequests int      `json:"half_open_requests,omitempty"`
+	// MaxConcurrent caps the calls in flight; calls beyond it are rejected. A
+	// negative value removes the cap.
+	MaxConcurrent int `json:"max_concurrent,omitempty"`
+}
+
+type ServiceConfig struct {
+	Policy
+	// LoadBalancing overrides Config.LoadBalancing for this service.
+	LoadBalancing string `json:"load_balancing,omitempty"`
+	// Methods is keyed by method name, either bare ("GetProductByID") or
+	// fully qualified ("/api.ProductService/GetProductByID").
+	Methods map[string]Policy `json:"methods,omitempty"`
+}
+
+// Config is the resilience configuration of every client a service creates.
+// Services are keyed by their discovery name.
+type Config struct {
+	Default Policy `json:"default"`
+	// LoadBalancing picks how calls spread over a service's instances:
+	// "round_robin" (the default), "least_request" or "weighted".
+	LoadBalancing string                   `json:"load_balancing,omitempty"`
+	Services      map[string]ServiceConfig `json:"services,omitempty"`
+}
+
+// DefaultPolicy makes one attempt within five seconds and opens the circuit
+// when half of the calls in ten seconds fail. Methods that opt in to more
+// attempts retry unavailable backends.
+var DefaultPolicy = Policy{
+	MaxAttempts:       1,
+	InitialBackoff:    Duration(100 * time.Millisecond),
+	MaxBackoff:        Duration(time.Second),
+	BackoffMultiplier: 2,
+	Jitter:            0.2,
+	RetryableCodes:    []string{"UNAVAILABLE"},
+	Timeout:           Duration(5 * time.Second),
+	FailureRate:       0.5,
+	MinimumRequests:   10,
+	Window:            Duration(10 * time.Second),
+	OpenDuration:      Duration(5 * time.Second),
+	HalfOpenRequests:  1,
+	MaxConcurrent:     100,
+}
+
+// IdempotentMethods lists, per service, the read methods that are retried
+// without being configured to: repeating them has no effect the first call
+// did not have.
+var IdempotentMethods = map[string][]string{
+	"product-service-grpc": {"GetProductByID", "GetProducts", "SearchProducts", "GetCategoryByID", "GetCategories"},
+	"order-service-grpc":   {"GetOrder", "GetOrderHistory"},
+	"payment-service-grpc": {"GetPayment", "ListPayments"},
+}
+
+// idempotentAttempts is how many attempts IdempotentMethods make by default.
+const idempotentAttempts = 3
+
+func DefaultConfig() *Config {
+	cfg := &Config{Default: DefaultPolicy}
+	cfg.retryIdempotent()
+	return cfg
+}
+
+// Load reads a JSON config file. Fields left out fall back to DefaultPolicy,
+// and IdempotentMethods keep their attempts unless the file sets them.
+func Load(path string) (*Config, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &Config{}
+	if err := json.Unmarshal(b, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	cfg.Default = DefaultPolicy.merge(cfg.Default)
+	cfg.retryIdempotent()
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("invalid resilience config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// LoadFromEnv loads the file named by RESILIENCE_CONFIG, or returns the
+// default config when it is not set.
+func LoadFromEnv() (*Config, error) {
+	path := utils.GetEnv("RESILIENCE_CONFIG")
+	if path == "" {
+		return DefaultConfig(), nil
+	}
+	return Load(path)
+}
+
+// retryIdempotent opts IdempotentMethods in to retries, except where the
+// service or method already sets its own max_attempts.
+func (c *Config) retryIdempotent() {
+	if c.Services == nil {
+		c.Services = map[string]ServiceConfig{}
+	}
+	for service, methods := range IdempotentMethods {
+		svc := c.Services[service]
+		if svc.MaxAttempts != 0 {
+			continue
+		}
+		if svc.Methods == nil {
+			svc.Methods = map[string]Policy{}
+		}
+		for _, method := range methods {
+			p := svc.Methods[method]
+			if p.MaxAttempts == 0 {
+				p.MaxAttempts = idempotentAttempts
+			}
+			svc.Methods[method] = p
+		}
+		c.Services[service] = svc
+	}
+}
+
+// Policy resolves the policy for method on service, most specific level
+// winning field by field.
+func (c *Config) Policy(service, method string) Policy {
+	p := c.Default
+	svc, ok := c.Services[service]
+	if !ok {
+		return p
+	}
+	p = p.merge(svc.Policy)
+	if m, ok := svc.Methods[method]; ok {
+		return p.merge(m)
+	}
+	if i := strings.LastIndex(method, "/"); i >= 0 {
+		if m, ok := svc.Methods[method[i+1:]]; ok {
+			return p.merge(m)
+		}
+	}
+	return p
+}
+
+// Balancing returns the load balancing policy for service.
+func (c *Config) Balancing(service string) string {
+	if svc, ok := c.Services[service]; ok && svc.LoadBalancing != "" {
+		return svc.LoadBalancing
+	}
+	if c.LoadBalancing != "" {
+		return c.LoadBalancing
+	}
+	return "round_robin"
+}
+
+func validBalancing(policy string) bool {
+	switch policy {
+	case "", "round_robin", "least_request", "weighted":
+		return true
+	}
+	return false
+}
+
+func (c *Config) validate() error {
+	if !validBalancing(c.LoadBalancing) {
+		return fmt.Errorf("unknown load_balancing %q", c.LoadBalancing)
+	}
+	check := func(where string, p Policy) error {
+		for _, name := range p.RetryableCodes {
+			if _, ok := codeByName(name); !ok {
+				return fmt.Errorf("%s: unknown status code %q", where, name)
+			}
+		}
+		if p.Jitter < 0 || p.Jitter > 1 {
+			return fmt.Errorf("%s: jitter must be between 0 and 1", where)
+		}
+		if p.FailureRate < 0 || p.FailureRate > 1 {
+			return fmt.Errorf("%s: failure_rate must be between 0 and 1", where)
+		}
+		return nil
+	}
+	if err := check("default", c.Default); err != nil {
+		return err
+	}
+	for name, svc := range c.Services {
+		if err := check(name, svc.Policy); err != nil {
+			return err
+		}
+		if !validBalancing(svc.LoadBalancing) {
+			return fmt.Errorf("%s: unknown load_balancing %q", name, svc.LoadBalancing)
+		}
+		for method, p := range svc.Methods {
+			if err := check(name+" "+method, p); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (p Policy) merge(o Policy) Policy {
+	if o.MaxAttempts != 0 {
+		p.MaxAttempts = o.MaxAttempts
+	}
+	if o.InitialBackoff != 0 {
+		p.InitialBackoff = o.InitialBackoff
+	}
+	if o.MaxBackoff != 0 {
+		p.MaxBackoff = o.MaxBackoff
+	}
+	if o.BackoffMultiplier != 0 {
+		p.BackoffMultiplier = o.BackoffMultiplier
+	}
+	if o.Jitter != 0 {
+		p.Jitter = o.Jitter
+	}
+	if o.RetryableCodes != nil {
+		p.RetryableCodes = o.RetryableCodes
+	}
+	if o.HedgingDelay != 0 {
+		p.HedgingDelay = o.HedgingDelay
+	}
+	if o.Timeout != 0 {
+		p.Timeout = o.Timeout
+	}
+	if o.FailureRate != 0 {
+		p.FailureRate = o.FailureRate
+	}
+	if o.MinimumRequests != 0 {
+		p.MinimumRequests = o.MinimumRequests
+	}
+	if o.Window != 0 {
+		p.Window = o.Window
+	}
+	if o.OpenDuration != 0 {
+		p.OpenDuration = o.OpenDuration
+	}
+	if o.HalfOpenRequests != 0 {
+		p.HalfOpenRequests = o.HalfOpenRequests
+	}
+	if o.MaxConcurrent != 0 {
+		p.MaxConcurrent = o.MaxConcurrent
+	}
+	return p
+}
+
+// retryable reports whether another attempt may succeed where err failed.
+// Calls rejected by a breaker or bulkhead are not retried; they would only be
+// rejected again.
+func (p Policy) retryable(err error) bool {
+	if errors.Is(err, ErrUnavailable) {
+		return false
+	}
+	code := status.Code(err)
+	for _, name := range p.RetryableCodes {
+		if c, ok := codeByName(name); ok && c == code {
+			return true
+		}
+	}
+	return false
+}
+
+func codeByName(name string) (codes.Code, bool) {
+	var c codes.Code
+	if err := c.UnmarshalJSON([]byte(`"` + strings.ToUpper(name) + `"`)); err != nil {
+		return 0, false
+	}
+	return c, true
+}
@@ -0,0 +1,139 @@
+package resilience
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeConfig(t *testing.T, body string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "resilience.json")
+	if err := os.WriteFile(path, []byte(body), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestDefaultConfigRetriesOnlyIdempotentMethods(t *testing.T) {
+	cfg := DefaultConfig()
+
+	tests := []struct {
+		service, method string
+		want            int
+	}{
+		{"product-service-grpc", "/api.ProductService/GetProductByID", idempotentAttempts},
+		{"product-service-grpc", "GetProducts", idempotentAttempts},
+		{"order-service-grpc", "/api.OrderService/GetOrder", idempotentAttempts},
+		{"payment-service-grpc", "/payments.PaymentService/CreatePayment", 1},
+		{"payment-service-grpc", "/payments.PaymentService/RefundPayment", 1},
+		{"payment-service-grpc", "/payments.PaymentService/CancelPayment", 1},
+		{"order-service-grpc", "/api.OrderService/SettleOrder", 1},
+		{"unknown-service", "Get", 1},
+	}
+	for _, tt := range tests {
+		if got := cfg.Policy(tt.service, tt.method).MaxAttempts; got != tt.want {
+			t.Errorf("%s %s: MaxAttempts = %d, want %d", tt.service, tt.method, got, tt.want)
+		}
+	}
+}
+
+func TestPolicyMerge(t *testing.T) {
+	cfg := &Config{
+		Default: DefaultPolicy,
+		Services: map[string]ServiceConfig{
+			"svc": {
+				Policy: Policy{Timeout: Duration(time.Second), RetryableCodes: []string{"UNAVAILABLE", "ABORTED"}},
+				Methods: map[string]Policy{
+					"Get":             {MaxAttempts: 4, Timeout: Duration(200 * time.Millisecond)},
+					"/pkg.Svc/Hedged": {MaxAttempts: 2, HedgingDelay: Duration(10 * time.Millisecond)},
+				},
+			},
+		},
+	}
+
+	p := cfg.Policy("svc", "/pkg.Svc/Get")
+	if p.MaxAttempts != 4 || p.Timeout != Duration(200*time.Millisecond) {
+		t.Errorf("method level: attempts %d, timeout %v", p.MaxAttempts, time.Duration(p.Timeout))
+	}
+	if len(p.RetryableCodes) != 2 || p.InitialBackoff != DefaultPolicy.InitialBackoff {
+		t.Errorf("method level lost inherited fields: %+v", p)
+	}
+
+	p = cfg.Policy("svc", "/pkg.Svc/Hedged")
+	if p.HedgingDelay != Duration(10*time.Millisecond) || p.MaxAttempts != 2 {
+		t.Errorf("fully qualified method: %+v", p)
+	}
+
+	p = cfg.Policy("svc", "/pkg.Svc/Other")
+	if p.MaxAttempts != 1 || p.Timeout != Duration(time.Second) {
+		t.Errorf("service level: attempts %d, timeout %v", p.MaxAttempts, time.Duration(p.Timeout))
+	}
+}
+
+func TestLoad(t *testing.T) {
+	path := writeConfig(t, `{
+		"default": {"timeout": "2s", "jitter": 0.1},
+		"load_balancing": "least_request",
+		"services": {
+			"product-service-grpc": {
+				"methods": {"GetProductByID": {"hedging_delay": "50ms"}}
+			},
+			"payment-service-grpc": {
+				"methods": {"CreatePayment": {"timeout": "10s"}}
+			},
+			"order-service-grpc": {"max_attempts": 2}
+		}
+	}`)
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg.Default.Timeout != Duration(2*time.Second) || cfg.Default.Jitter != 0.1 {
+		t.Errorf("default overrides lost: %+v", cfg.Default)
+	}
+	if cfg.Default.MaxAttempts != 1 || cfg.Default.MaxConcurrent != DefaultPolicy.MaxConcurrent {
+		t.Errorf("default did not fall back to DefaultPolicy: %+v", cfg.Default)
+	}
+	if got := cfg.Balancing("product-service-grpc"); got != "least_request" {
+		t.Errorf("Balancing = %s, want least_request", got)
+	}
+
+	p := cfg.Policy("product-service-grpc", "/api.ProductService/GetProductByID")
+	if p.MaxAttempts != idempotentAttempts || p.HedgingDelay != Duration(50*time.Millisecond) {
+		t.Errorf("configured idempotent method: %+v", p)
+	}
+	if got := cfg.Policy("product-service-grpc", "GetCategories").MaxAttempts; got != idempotentAttempts {
+		t.Errorf("unconfigured idempotent method: MaxAttempts = %d", got)
+	}
+	p = cfg.Policy("payment-service-grpc", "CreatePayment")
+	if p.MaxAttempts != 1 || p.Timeout != Duration(10*time.Second) {
+		t.Errorf("non-idempotent method: %+v", p)
+	}
+	if got := cfg.Policy("order-service-grpc", "GetOrder").MaxAttempts; got != 2 {
+		t.Errorf("service max_attempts overridden by default: %d", got)
+	}
+}
+
+func TestLoadRejects(t *testing.T) {
+	for name, body := range map[string]string{
+		"bad json":          `{`,
+		"bad duration":      `{"default": {"timeout": "soon"}}`,
+		"numeric duration":  `{"default": {"timeout": 5}}`,
+		"unknown code":      `{"default": {"retryable_status_codes": ["NOPE"]}}`,
+		"jitter":            `{"default": {"jitter": 1.5}}`,
+		"failure rate":      `{"services": {"s": {"failure_rate": -0.1}}}`,
+		"balancing":         `{"load_balancing": "random"}`,
+		"service balancing": `{"services": {"s": {"load_balancing": "random"}}}`,
+		"method code":       `{"services": {"s": {"methods": {"M": {"retryable_status_codes": ["NOPE"]}}}}}`,
+	} {
+		if _, err := Load(writeConfig(t, body)); err == nil {
+			t.Errorf("%s: Load succeeded", name)
+		}
+	}
+	if _, err := Load(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("missing file: Load succeeded")
+	}
+}
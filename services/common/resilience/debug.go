@@ -0,0 +1,14 @@
+package resilience
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// DebugHandler serves the state of every breaker in the process as JSON.
+func DebugHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"breakers": Breakers()})
+	})
+}
@@ -0,0 +1,37 @@
+package resilience
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrUnavailable matches every call rejected locally by a circuit breaker or
+// bulkhead, through errors.Is.
+var ErrUnavailable = errors.New("service unavailable")
+
+// UnavailableError is returned instead of calling a backend that is known to
+// be failing or saturated. It carries the Unavailable gRPC code, so it
+// travels through status.FromError like any other gRPC error.
+type UnavailableError struct {
+	Service string
+	Method  string
+	Reason  string
+	// RetryAfter is how long the circuit stays open, when known.
+	RetryAfter time.Duration
+}
+
+func (e *UnavailableError) Error() string {
+	return fmt.Sprintf("%s %s unavailable: %s", e.Service, e.Method, e.Reason)
+}
+
+func (e *UnavailableError) Is(target error) bool {
+	return target == ErrUnavailable
+}
+
+func (e *UnavailableError) GRPCStatus() *status.Status {
+	return status.New(codes.Unavailable, e.Error())
+}
@@ -0,0 +1,43 @@
+package resilience
+
+import (
+	"context"
+	"log"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc/status"
+)
+
+const scope = "github.com/daffaromero/retries/services/common/resilience"
+
+type metrics struct {
+	attempts metric.Int64Counter
+}
+
+// newMetrics records on the global meter provider, so attempts show up
+// wherever the service exports its otel metrics.
+func newMetrics() *metrics {
+	attempts, err := otel.Meter(scope).Int64Counter("rpc.client.attempts",
+		metric.WithDescription("Attempts made by resilient gRPC clients, retries and hedges included."),
+		metric.WithUnit("{attempt}"),
+	)
+	if err != nil {
+		log.Printf("failed to create attempts counter: %v", err)
+	}
+	return &metrics{attempts: attempts}
+}
+
+func (m *metrics) attempt(ctx context.Context, service, method string, attempt int, err error) {
+	if m.attempts == nil {
+		return
+	}
+	// The call's own context may already be cancelled by a winning hedge.
+	m.attempts.Add(context.WithoutCancel(ctx), 1, metric.WithAttributes(
+		attribute.String("rpc.service", service),
+		attribute.String("rpc.method", method),
+		attribute.String("rpc.grpc.status_code", status.Code(err).String()),
+		attribute.Bool("retry", attempt > 1),
+	))
+}
@@ -0,0 +1,134 @@
+package resilience
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// UnaryClientInterceptor applies cfg to the unary calls a client of service
+// makes. Streams are left alone: a stream that has delivered messages can not
+// be replayed safely.
+func UnaryClientInterceptor(service string, cfg *Config) grpc.UnaryClientInterceptor {
+	m := newMetrics()
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		p := cfg.Policy(service, method)
+		if p.Timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, time.Duration(p.Timeout))
+			defer cancel()
+		}
+
+		call := func(ctx context.Context, attempt int, reply any) error {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			m.attempt(ctx, service, method, attempt, err)
+			return err
+		}
+		if p.HedgingDelay > 0 && p.MaxAttempts > 1 {
+			return hedge(ctx, p, reply, call)
+		}
+		return retry(ctx, p, reply, call)
+	}
+}
+
+type attemptFunc func(ctx context.Context, attempt int, reply any) error
+
+func retry(ctx context.Context, p Policy, reply any, call attemptFunc) error {
+	for attempt := 1; ; attempt++ {
+		err := call(ctx, attempt, reply)
+		if err == nil || attempt >= p.MaxAttempts || !p.retryable(err) {
+			return err
+		}
+		select {
+		case <-time.After(p.backoff(attempt)):
+		case <-ctx.Done():
+			return err
+		}
+	}
+}
+
+// hedge sends the first attempt and another one every HedgingDelay, up to
+// MaxAttempts in flight. The first success wins and cancels the rest; a
+// non-retryable failure ends the call at once.
+func hedge(ctx context.Context, p Policy, reply any, call attemptFunc) error {
+	msg, ok := reply.(proto.Message)
+	if !ok {
+		return retry(ctx, p, reply, call)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		reply proto.Message
+		err   error
+	}
+	results := make(chan result, p.MaxAttempts)
+	send := func(attempt int) {
+		r := msg.ProtoReflect().New().Interface()
+		go func() {
+			results <- result{reply: r, err: call(ctx, attempt, r)}
+		}()
+	}
+
+	send(1)
+	sent, pending := 1, 1
+	timer := time.NewTimer(time.Duration(p.HedgingDelay))
+	defer timer.Stop()
+
+	var lastErr error
+	for {
+		select {
+		case res := <-results:
+			pending--
+			if res.err == nil {
+				proto.Reset(msg)
+				proto.Merge(msg, res.reply)
+				return nil
+			}
+			lastErr = res.err
+			if !p.retryable(res.err) {
+				return res.err
+			}
+			if pending == 0 && sent < p.MaxAttempts {
+				sent++
+				pending++
+				send(sent)
+				timer.Reset(time.Duration(p.HedgingDelay))
+			}
+			if pending == 0 {
+				return lastErr
+			}
+		case <-timer.C:
+			if sent < p.MaxAttempts {
+				sent++
+				pending++
+				send(sent)
+				timer.Reset(time.Duration(p.HedgingDelay))
+			}
+		case <-ctx.Done():
+			if lastErr != nil {
+				return lastErr
+			}
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+}
+
+// backoff is the wait before the attempt after attempt, growing
+// exponentially up to MaxBackoff and spread by Jitter.
+func (p Policy) backoff(attempt int) time.Duration {
+	d := float64(p.InitialBackoff) * math.Pow(p.BackoffMultiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 {
+		d = math.Min(d, float64(p.MaxBackoff))
+	}
+	if p.Jitter > 0 {
+		d *= 1 + p.Jitter*(2*rand.Float64()-1)
+	}
+	return time.Duration(d)
+}
@@ -0,0 +1,173 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestBackoff(t *testing.T) {
+	p := Policy{
+		InitialBackoff:    Duration(100 * time.Millisecond),
+		MaxBackoff:        Duration(time.Second),
+		BackoffMultiplier: 2,
+	}
+	for attempt, want := range map[int]time.Duration{
+		1:  100 * time.Millisecond,
+		2:  200 * time.Millisecond,
+		4:  800 * time.Millisecond,
+		5:  time.Second,
+		50: time.Second,
+	} {
+		if got := p.backoff(attempt); got != want {
+			t.Errorf("backoff(%d) = %v, want %v", attempt, got, want)
+		}
+	}
+
+	p.Jitter = 0.2
+	for attempt := 1; attempt <= 10; attempt++ {
+		base := Policy{InitialBackoff: p.InitialBackoff, MaxBackoff: p.MaxBackoff, BackoffMultiplier: 2}.backoff(attempt)
+		lo, hi := time.Duration(float64(base)*0.8), time.Duration(float64(base)*1.2)
+		for i := 0; i < 100; i++ {
+			if got := p.backoff(attempt); got < lo || got > hi {
+				t.Fatalf("backoff(%d) with jitter = %v, want within [%v, %v]", attempt, got, lo, hi)
+			}
+		}
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	p := Policy{RetryableCodes: []string{"UNAVAILABLE", "resource_exhausted"}}
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{status.Error(codes.Unavailable, "down"), true},
+		{status.Error(codes.ResourceExhausted, "busy"), true},
+		{status.Error(codes.InvalidArgument, "bad"), false},
+		{status.Error(codes.DeadlineExceeded, "slow"), false},
+		{&UnavailableError{Service: "s", Method: "m", Reason: "circuit open"}, false},
+		{errors.New("plain"), false},
+	}
+	for _, tt := range tests {
+		if got := p.retryable(tt.err); got != tt.want {
+			t.Errorf("retryable(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+	if (Policy{}).retryable(status.Error(codes.Unavailable, "down")) {
+		t.Error("policy without retryable codes retried")
+	}
+}
+
+func fastPolicy(attempts int) Policy {
+	return Policy{
+		MaxAttempts:       attempts,
+		InitialBackoff:    Duration(time.Millisecond),
+		BackoffMultiplier: 1,
+		RetryableCodes:    []string{"UNAVAILABLE"},
+	}
+}
+
+func TestRetry(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "down")
+	tests := []struct {
+		name      string
+		attempts  int
+		errs      []error
+		wantCalls int
+		wantErr   bool
+	}{
+		{"single attempt by default", 1, []error{unavailable, nil}, 1, true},
+		{"retried until success", 3, []error{unavailable, unavailable, nil}, 3, false},
+		{"gives up after max attempts", 3, []error{unavailable, unavailable, unavailable, nil}, 3, true},
+		{"non-retryable error ends the call", 3, []error{status.Error(codes.InvalidArgument, "bad"), nil}, 1, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			err := retry(context.Background(), fastPolicy(tt.attempts), nil, func(ctx context.Context, attempt int, reply any) error {
+				calls++
+				return tt.errs[attempt-1]
+			})
+			if calls != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", calls, tt.wantCalls)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("err = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHedgeCancelsSlowAttempts(t *testing.T) {
+	p := fastPolicy(3)
+	p.HedgingDelay = Duration(10 * time.Millisecond)
+
+	cancelled := make(chan int, p.MaxAttempts)
+	reply := &wrapperspb.StringValue{}
+	err := hedge(context.Background(), p, reply, func(ctx context.Context, attempt int, r any) error {
+		if attempt == 2 {
+			r.(*wrapperspb.StringValue).Value = strconv.Itoa(attempt)
+			return nil
+		}
+		// The other attempts hang until the winner cancels them.
+		<-ctx.Done()
+		cancelled <- attempt
+		return status.FromContextError(ctx.Err()).Err()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reply.Value != "2" {
+		t.Errorf("reply = %q, want the second attempt's", reply.Value)
+	}
+
+	select {
+	case attempt := <-cancelled:
+		if attempt != 1 {
+			t.Errorf("cancelled attempt %d, want 1", attempt)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("first attempt was not cancelled")
+	}
+	select {
+	case attempt := <-cancelled:
+		t.Errorf("attempt %d was sent after the call succeeded", attempt)
+	case <-time.After(3 * time.Duration(p.HedgingDelay)):
+	}
+}
+
+func TestHedgeStopsOnNonRetryableError(t *testing.T) {
+	p := fastPolicy(3)
+	p.HedgingDelay = Duration(time.Hour)
+
+	calls := 0
+	err := hedge(context.Background(), p, &wrapperspb.StringValue{}, func(ctx context.Context, attempt int, r any) error {
+		calls++
+		return status.Error(codes.InvalidArgument, "bad")
+	})
+	if status.Code(err) != codes.InvalidArgument || calls != 1 {
+		t.Errorf("err = %v after %d calls, want InvalidArgument after 1", err, calls)
+	}
+}
+
+func TestHedgeHonoursDeadline(t *testing.T) {
+	p := fastPolicy(2)
+	p.HedgingDelay = Duration(time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err := hedge(ctx, p, &wrapperspb.StringValue{}, func(ctx context.Context, attempt int, r any) error {
+		<-ctx.Done()
+		return nil
+	})
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("err = %v, want DeadlineExceeded", err)
+	}
+}
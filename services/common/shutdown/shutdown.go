@@ -0,0 +1,121 @@
+// Package shutdown stops a service in order when it receives SIGINT or
+// SIGTERM: steps run one after another and share one deadline, so a stuck
+// step can not keep the process alive forever.
+package shutdown
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/daffaromero/retries/services/common/utils"
+	"google.golang.org/grpc"
+)
+
+// DefaultTimeout is how long shutdown may take when SHUTDOWN_TIMEOUT is not
+// set.
+const DefaultTimeout = 15 * time.Second
+
+type step struct {
+	name string
+	fn   func(ctx context.Context) error
+}
+
+// Sequence is an ordered list of shutdown steps.
+type Sequence struct {
+	timeout time.Duration
+	steps   []step
+}
+
+// New returns an empty sequence that must finish within timeout.
+func New(timeout time.Duration) *Sequence {
+	return &Sequence{timeout: timeout}
+}
+
+// TimeoutFromEnv reads SHUTDOWN_TIMEOUT, a Go duration such as "30s".
+func TimeoutFromEnv() (time.Duration, error) {
+	v := utils.GetEnv("SHUTDOWN_TIMEOUT")
+	if v == "" {
+		return DefaultTimeout, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid SHUTDOWN_TIMEOUT %q", v)
+	}
+	return d, nil
+}
+
+// Add appends a step. Steps run in the order they were added.
+func (s *Sequence) Add(name string, fn func(ctx context.Context) error) {
+	s.steps = append(s.steps, step{name: name, fn: fn})
+}
+
+// Wait blocks until the process receives SIGINT or SIGTERM, then runs the
+// steps. A failing step is logged and the next one still runs.
+func (s *Sequence) Wait() {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	<-ctx.Done()
+	stop()
+
+	log.Printf("Shutting down, waiting up to %s", s.timeout)
+	s.Run()
+}
+
+// Run runs the steps now.
+func (s *Sequence) Run() {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	for _, st := range s.steps {
+		start := time.Now()
+		if err := st.fn(ctx); err != nil {
+			log.Printf("Shutdown step %s failed: %v", st.name, err)
+			continue
+		}
+		log.Printf("Shutdown step %s done in %s", st.name, time.Since(start).Round(time.Millisecond))
+	}
+}
+
+// GRPCServer drains srv: it stops accepting connections and waits for
+// in-flight RPCs, cutting them off when ctx is done.
+func GRPCServer(srv *grpc.Server) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		done := make(chan struct{})
+		go func() {
+			srv.GracefulStop()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+			return nil
+		case <-ctx.Done():
+			srv.Stop()
+			<-done
+			return fmt.Errorf("in-flight RPCs cut off: %w", ctx.Err())
+		}
+	}
+}
+
+// All runs fns at the same time, so they share the deadline instead of
+// queueing for it, and returns their joined errors.
+func All(fns ...func(ctx context.Context) error) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		errs := make([]error, len(fns))
+		var wg sync.WaitGroup
+		for i, fn := range fns {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs[i] = fn(ctx)
+			}()
+		}
+		wg.Wait()
+		return errors.Join(errs...)
+	}
+}
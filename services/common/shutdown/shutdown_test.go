@@ -0,0 +1,139 @@
+package shutdown
+
+import (
+	"context"
+	"errors"
+	"net"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestRunOrder(t *testing.T) {
+	var ran []string
+	s := New(time.Second)
+	for _, name := range []string{"stop accepting", "drain", "close db"} {
+		s.Add(name, func(ctx context.Context) error {
+			ran = append(ran, name)
+			if name == "drain" {
+				return errors.New("drain failed")
+			}
+			return nil
+		})
+	}
+	s.Run()
+
+	if want := []string{"stop accepting", "drain", "close db"}; !slices.Equal(ran, want) {
+		t.Errorf("steps ran as %v, want %v", ran, want)
+	}
+}
+
+func TestRunSharesDeadline(t *testing.T) {
+	var late error
+	s := New(50 * time.Millisecond)
+	s.Add("stuck", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	s.Add("after", func(ctx context.Context) error {
+		late = ctx.Err()
+		return nil
+	})
+
+	start := time.Now()
+	s.Run()
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Run took %s with a 50ms timeout", elapsed)
+	}
+	if !errors.Is(late, context.DeadlineExceeded) {
+		t.Errorf("step after a stuck one saw %v, want the spent deadline", late)
+	}
+}
+
+func TestAll(t *testing.T) {
+	var wg sync.WaitGroup
+	wg.Add(2)
+	wait := func(ctx context.Context) error {
+		// Each fn only returns once the other has started.
+		wg.Done()
+		wg.Wait()
+		return nil
+	}
+	fail := func(ctx context.Context) error { return errors.New("close failed") }
+
+	done := make(chan error, 1)
+	go func() { done <- All(wait, wait, fail)(context.Background()) }()
+	select {
+	case err := <-done:
+		if err == nil || err.Error() != "close failed" {
+			t.Errorf("All = %v, want the failing fn's error", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("All ran its fns one after another")
+	}
+}
+
+// serve starts a gRPC server with a health service and returns a client
+// connection to it.
+func serve(t *testing.T) (*grpc.Server, healthpb.HealthClient) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer()
+	healthpb.RegisterHealthServer(srv, health.NewServer())
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return srv, healthpb.NewHealthClient(conn)
+}
+
+func TestGRPCServerDrains(t *testing.T) {
+	srv, client := serve(t)
+	if _, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := GRPCServer(srv)(context.Background()); err != nil {
+		t.Errorf("GRPCServer with nothing in flight = %v", err)
+	}
+}
+
+func TestGRPCServerStopsAtDeadline(t *testing.T) {
+	srv, client := serve(t)
+
+	// Watch streams until the server ends it, so GracefulStop waits on it.
+	stream, err := client.Watch(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := stream.Recv(); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err = GRPCServer(srv)(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("GRPCServer = %v, want the deadline error", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("GRPCServer took %s with a 50ms deadline", elapsed)
+	}
+	if _, err := stream.Recv(); err == nil {
+		t.Error("in-flight stream still open after Stop")
+	}
+}
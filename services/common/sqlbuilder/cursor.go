@@ -0,0 +1,84 @@
+package sqlbuilder
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// cursor is the position after which the next page starts: the sort it was
+// issued for and the sort value and id of the last row of the page. The
+// value is kept as text with its type so it goes back to the database as
+// the same type it came out as.
+type cursor struct {
+	Sort  string `json:"s,omitempty"`
+	Desc  bool   `json:"d,omitempty"`
+	Type  string `json:"t,omitempty"`
+	Value string `json:"v,omitempty"`
+	ID    string `json:"id"`
+}
+
+func encodeCursor(sort string, desc bool, value any, id string) string {
+	c := cursor{Sort: sort, Desc: desc, ID: id}
+	if sort != "" {
+		switch v := value.(type) {
+		case string:
+			c.Type, c.Value = "string", v
+		case int32:
+			c.Type, c.Value = "int", strconv.FormatInt(int64(v), 10)
+		case int64:
+			c.Type, c.Value = "int", strconv.FormatInt(v, 10)
+		case int:
+			c.Type, c.Value = "int", strconv.Itoa(v)
+		case float64:
+			c.Type, c.Value = "float", strconv.FormatFloat(v, 'g', -1, 64)
+		case time.Time:
+			c.Type, c.Value = "time", v.Format(time.RFC3339Nano)
+		case *timestamppb.Timestamp:
+			c.Type, c.Value = "time", v.AsTime().Format(time.RFC3339Nano)
+		default:
+			panic(fmt.Sprintf("sqlbuilder: can not put a %T in a cursor", value))
+		}
+	}
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(s string) (*cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidFilter)
+	}
+	var c cursor
+	if err := json.Unmarshal(b, &c); err != nil || c.ID == "" {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidFilter)
+	}
+	return &c, nil
+}
+
+func (c *cursor) value() (any, error) {
+	var (
+		v   any
+		err error
+	)
+	switch c.Type {
+	case "string":
+		v = c.Value
+	case "int":
+		v, err = strconv.ParseInt(c.Value, 10, 64)
+	case "float":
+		v, err = strconv.ParseFloat(c.Value, 64)
+	case "time":
+		v, err = time.Parse(time.RFC3339Nano, c.Value)
+	default:
+		err = fmt.Errorf("unknown type %q", c.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", ErrInvalidFilter)
+	}
+	return v, nil
+}
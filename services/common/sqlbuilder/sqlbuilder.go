@@ -0,0 +1,244 @@
+// Package sqlbuilder assembles SELECT statements from request filters
+// without ever putting request values into the SQL text: every value becomes
+// a numbered placeholder with a matching argument, and sort keys are looked
+// up in an allow-list instead of being copied into ORDER BY.
+//
+// Column names and condition templates must come from code, never from a
+// request.
+package sqlbuilder
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidFilter is returned for filters a request can not ask for, such
+// as sorting by a key that is not in the allow-list or passing a malformed
+// cursor. Callers report it as a bad request.
+var ErrInvalidFilter = errors.New("invalid filter")
+
+// MaxLimit caps the page size a request can ask for.
+const MaxLimit = 100
+
+type cond struct {
+	sql  string
+	args []any
+}
+
+// Query is a SELECT statement under construction.
+type Query struct {
+	columns string
+	from    cond
+	id      string
+
+	conds  []cond
+	after  *cond
+	sort   string
+	column string
+	desc   bool
+	limit  int32
+	offset int32
+}
+
+// Select starts a query for columns from a table. id is a unique column of
+// the table: it breaks ties between rows with equal sort values and anchors
+// cursors. Each ? in from is replaced by the placeholder of the next of
+// args, for tables computed from a request, such as a search query.
+func Select(columns, from, id string, args ...any) *Query {
+	checkArgs(from, args)
+	return &Query{columns: columns, from: cond{sql: from, args: args}, id: id}
+}
+
+// Where adds a condition, joined to the others with AND. Each ? in sql is
+// replaced by the placeholder of the next of args.
+func (q *Query) Where(sql string, args ...any) *Query {
+	checkArgs(sql, args)
+	q.conds = append(q.conds, cond{sql: sql, args: args})
+	return q
+}
+
+// Eq adds column = value.
+func (q *Query) Eq(column string, value any) *Query {
+	return q.Where(column+" = ?", value)
+}
+
+// Any adds column = ANY(values), matching any of values.
+func (q *Query) Any(column string, values any) *Query {
+	return q.Where(column+" = ANY(?)", values)
+}
+
+// Sort orders by the column allowed maps key onto, descending if desc, and
+// then by the id column in the same direction. An empty key orders by id
+// alone. Columns in allowed must not be NULL, or cursors will skip rows;
+// wrap nullable ones in COALESCE.
+func (q *Query) Sort(allowed map[string]string, key string, desc bool) error {
+	if key == "" {
+		q.sort, q.column, q.desc = "", "", desc
+		return nil
+	}
+	column, ok := allowed[key]
+	if !ok {
+		return fmt.Errorf("%w: can not sort by %q", ErrInvalidFilter, key)
+	}
+	q.sort, q.column, q.desc = key, column, desc
+	return nil
+}
+
+// Paginate sets LIMIT and OFFSET. A zero limit means no limit.
+func (q *Query) Paginate(limit, offset int32) *Query {
+	q.limit, q.offset = max(limit, 0), max(offset, 0)
+	return q
+}
+
+// After starts the page after the row cursor points at, in place of any
+// offset. It must be called after Sort, and the cursor must have been issued
+// for the same sort. An empty cursor starts at the beginning.
+func (q *Query) After(cursor string) error {
+	if cursor == "" {
+		return nil
+	}
+	c, err := decodeCursor(cursor)
+	if err != nil {
+		return err
+	}
+	if c.Sort != q.sort || c.Desc != q.desc {
+		return fmt.Errorf("%w: cursor was issued for a different sort", ErrInvalidFilter)
+	}
+
+	op := ">"
+	if q.desc {
+		op = "<"
+	}
+	if q.column == "" {
+		q.after = &cond{sql: q.id + " " + op + " ?", args: []any{c.ID}}
+	} else {
+		value, err := c.value()
+		if err != nil {
+			return err
+		}
+		q.after = &cond{sql: "(" + q.column + ", " + q.id + ") " + op + " (?, ?)", args: []any{value, c.ID}}
+	}
+	return nil
+}
+
+// Build returns the statement and its arguments. With a limit, the statement
+// fetches one row more than the page so Next can tell whether another page
+// follows.
+func (q *Query) Build() (string, []any) {
+	var b strings.Builder
+	var args []any
+	b.WriteString("SELECT " + q.columns + " FROM ")
+	render(&b, q.from, &args)
+
+	conds := q.conds
+	if q.after != nil {
+		conds = append(conds[:len(conds):len(conds)], *q.after)
+	}
+	where(&b, conds, &args)
+
+	dir := " ASC"
+	if q.desc {
+		dir = " DESC"
+	}
+	b.WriteString(" ORDER BY ")
+	if q.column != "" {
+		b.WriteString(q.column + dir + ", ")
+	}
+	b.WriteString(q.id + dir)
+
+	if q.limit > 0 {
+		args = append(args, q.limit+1)
+		b.WriteString(" LIMIT $" + strconv.Itoa(len(args)))
+	}
+	if q.offset > 0 && q.after == nil {
+		args = append(args, q.offset)
+		b.WriteString(" OFFSET $" + strconv.Itoa(len(args)))
+	}
+	return b.String(), args
+}
+
+// Count returns a statement counting every row the filters match, ignoring
+// sorting and pagination.
+func (q *Query) Count() (string, []any) {
+	return q.Aggregate("count(*)", "")
+}
+
+// Aggregate returns a statement selecting columns over every row the filters
+// match, followed by rest, such as a GROUP BY clause. Like Count, it ignores
+// sorting and pagination.
+func (q *Query) Aggregate(columns, rest string) (string, []any) {
+	var b strings.Builder
+	var args []any
+	b.WriteString("SELECT " + columns + " FROM ")
+	render(&b, q.from, &args)
+	where(&b, q.conds, &args)
+	if rest != "" {
+		b.WriteString(" " + rest)
+	}
+	return b.String(), args
+}
+
+func checkArgs(sql string, args []any) {
+	if n := strings.Count(sql, "?"); n != len(args) {
+		panic(fmt.Sprintf("sqlbuilder: %q has %d placeholders but %d args", sql, n, len(args)))
+	}
+}
+
+func where(b *strings.Builder, conds []cond, args *[]any) {
+	for i, c := range conds {
+		if i == 0 {
+			b.WriteString(" WHERE ")
+		} else {
+			b.WriteString(" AND ")
+		}
+		render(b, c, args)
+	}
+}
+
+// render writes c with each ? replaced by the placeholder of its arg,
+// numbered after the args already collected.
+func render(b *strings.Builder, c cond, args *[]any) {
+	rest := c.sql
+	for _, arg := range c.args {
+		before, after, _ := strings.Cut(rest, "?")
+		*args = append(*args, arg)
+		b.WriteString(before + "$" + strconv.Itoa(len(*args)))
+		rest = after
+	}
+	b.WriteString(rest)
+}
+
+// Next trims rows fetched with q to the page size and returns the cursor of
+// the page after them, or "" if there is none. key returns a row's value
+// for the sort key q was built with, and its id.
+func Next[T any](q *Query, rows []T, key func(row T, sort string) (value any, id string)) ([]T, string) {
+	if q.limit <= 0 || len(rows) <= int(q.limit) {
+		return rows, ""
+	}
+	rows = rows[:q.limit]
+	value, id := key(rows[len(rows)-1], q.sort)
+	return rows, encodeCursor(q.sort, q.desc, value, id)
+}
+
+// Page returns the limit and offset for a request's pagination fields. An
+// explicit offset wins over a page number, and a missing limit falls back to
+// defaultLimit, where 0 means no limit. Limits are capped at MaxLimit.
+func Page(page, limit, offset, defaultLimit int32) (int32, int32) {
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	limit = min(limit, MaxLimit)
+	if offset <= 0 && page > 1 {
+		offset = (page - 1) * limit
+	}
+	return limit, offset
+}
+
+// Contains returns an ILIKE pattern matching s anywhere, with the wildcards
+// in s escaped so they match literally.
+func Contains(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return "%" + r.Replace(s) + "%"
+}
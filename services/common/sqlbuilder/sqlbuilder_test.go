@@ -0,0 +1,193 @@
+package sqlbuilder_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/daffaromero/retries/services/common/sqlbuilder"
+	"github.com/daffaromero/retries/services/common/sqlbuilder/sqltest"
+)
+
+var sorts = map[string]string{
+	"name":       "name",
+	"created_at": "created_at",
+}
+
+type row struct {
+	id      string
+	name    string
+	created time.Time
+}
+
+func rowKey(r row, sort string) (any, string) {
+	switch sort {
+	case "name":
+		return r.name, r.id
+	case "created_at":
+		return r.created, r.id
+	}
+	return nil, r.id
+}
+
+func TestBuild(t *testing.T) {
+	tests := []struct {
+		name  string
+		build func(*sqlbuilder.Query) error
+	}{
+		{"bare", func(q *sqlbuilder.Query) error { return nil }},
+		{"conditions", func(q *sqlbuilder.Query) error {
+			q.Where("deleted_at IS NULL")
+			q.Eq("visibility", "public")
+			q.Where("price BETWEEN ? AND ?", 10, 20)
+			q.Any("category_name", []string{"books", "games"})
+			return nil
+		}},
+		{"search", func(q *sqlbuilder.Query) error {
+			pattern := sqlbuilder.Contains("50%_off' OR 1=1 --")
+			q.Where("(name ILIKE ? OR description ILIKE ?)", pattern, pattern)
+			return nil
+		}},
+		{"sorted_page", func(q *sqlbuilder.Query) error {
+			q.Eq("seller_id", "s1")
+			q.Paginate(10, 20)
+			return q.Sort(sorts, "name", true)
+		}},
+		{"no_limit", func(q *sqlbuilder.Query) error {
+			q.Paginate(0, 5)
+			return nil
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := sqlbuilder.Select("id, name", "products", "id")
+			if err := tt.build(q); err != nil {
+				t.Fatal(err)
+			}
+			sql, args := q.Build()
+			sqltest.Golden(t, tt.name, sql, args)
+		})
+	}
+}
+
+func TestCount(t *testing.T) {
+	q := sqlbuilder.Select("id, name", "products", "id")
+	q.Eq("seller_id", "s1")
+	q.Paginate(10, 0)
+	if err := q.Sort(sorts, "name", false); err != nil {
+		t.Fatal(err)
+	}
+	sql, args := q.Count()
+	sqltest.Golden(t, "count", sql, args)
+}
+
+func TestCursor(t *testing.T) {
+	created := time.Date(2024, 5, 1, 12, 30, 0, 0, time.UTC)
+	rows := []row{
+		{"a", "alpha", created},
+		{"b", "beta", created.Add(time.Hour)},
+		{"c", "gamma", created.Add(2 * time.Hour)},
+	}
+
+	for name, sort := range map[string]string{"id": "", "name": "name", "created_at": "created_at"} {
+		t.Run(name, func(t *testing.T) {
+			q := sqlbuilder.Select("id, name", "products", "id")
+			q.Eq("seller_id", "s1")
+			q.Paginate(2, 0)
+			if err := q.Sort(sorts, sort, true); err != nil {
+				t.Fatal(err)
+			}
+			page, next := sqlbuilder.Next(q, rows, rowKey)
+			if len(page) != 2 || next == "" {
+				t.Fatalf("Next returned %d rows and cursor %q, want 2 rows and a cursor", len(page), next)
+			}
+
+			q = sqlbuilder.Select("id, name", "products", "id")
+			q.Eq("seller_id", "s1")
+			q.Paginate(2, 40)
+			if err := q.Sort(sorts, sort, true); err != nil {
+				t.Fatal(err)
+			}
+			if err := q.After(next); err != nil {
+				t.Fatal(err)
+			}
+			sql, args := q.Build()
+			sqltest.Golden(t, "after_"+name, sql, args)
+		})
+	}
+
+	t.Run("last_page", func(t *testing.T) {
+		q := sqlbuilder.Select("id, name", "products", "id").Paginate(3, 0)
+		if _, next := sqlbuilder.Next(q, rows, rowKey); next != "" {
+			t.Errorf("Next on the last page returned cursor %q", next)
+		}
+	})
+}
+
+func TestAfterRejects(t *testing.T) {
+	rows := []row{{id: "a", name: "alpha"}, {id: "b", name: "beta"}}
+	q := sqlbuilder.Select("id, name", "products", "id").Paginate(1, 0)
+	if err := q.Sort(sorts, "name", false); err != nil {
+		t.Fatal(err)
+	}
+	_, byName := sqlbuilder.Next(q, rows, rowKey)
+
+	for name, tt := range map[string]struct {
+		sort   string
+		desc   bool
+		cursor string
+	}{
+		"other key":       {"created_at", false, byName},
+		"other direction": {"name", true, byName},
+		"not base64":      {"name", false, "!!!"},
+		"not json":        {"name", false, "bm90IGpzb24"},
+	} {
+		q := sqlbuilder.Select("id, name", "products", "id")
+		if err := q.Sort(sorts, tt.sort, tt.desc); err != nil {
+			t.Fatal(err)
+		}
+		if err := q.After(tt.cursor); !errors.Is(err, sqlbuilder.ErrInvalidFilter) {
+			t.Errorf("%s: After = %v, want ErrInvalidFilter", name, err)
+		}
+	}
+}
+
+func TestSortRejectsUnknownKeys(t *testing.T) {
+	q := sqlbuilder.Select("id", "products", "id")
+	for _, key := range []string{"price", "name; DROP TABLE products", "NAME"} {
+		if err := q.Sort(sorts, key, false); !errors.Is(err, sqlbuilder.ErrInvalidFilter) {
+			t.Errorf("Sort(%q) = %v, want ErrInvalidFilter", key, err)
+		}
+	}
+	if sql, _ := q.Build(); sql != "SELECT id FROM products ORDER BY id ASC" {
+		t.Errorf("rejected sorts changed the query: %s", sql)
+	}
+}
+
+func TestPage(t *testing.T) {
+	tests := []struct {
+		page, limit, offset, def int32
+		wantLimit, wantOffset    int32
+	}{
+		{0, 0, 0, 10, 10, 0},
+		{3, 20, 0, 10, 20, 40},
+		{3, 20, 5, 10, 20, 5},
+		{1, 500, 0, 10, sqlbuilder.MaxLimit, 0},
+		{0, 0, 0, 0, 0, 0},
+	}
+	for _, tt := range tests {
+		limit, offset := sqlbuilder.Page(tt.page, tt.limit, tt.offset, tt.def)
+		if limit != tt.wantLimit || offset != tt.wantOffset {
+			t.Errorf("Page(%d, %d, %d, %d) = %d, %d, want %d, %d", tt.page, tt.limit, tt.offset, tt.def, limit, offset, tt.wantLimit, tt.wantOffset)
+		}
+	}
+}
+
+func TestWherePanicsOnArgMismatch(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Where with too few args did not panic")
+		}
+	}()
+	sqlbuilder.Select("1", "t", "id").Where("a = ? AND b = ?", 1)
+}
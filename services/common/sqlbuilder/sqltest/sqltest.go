@@ -0,0 +1,46 @@
+// Package sqltest compares generated SQL against golden files.
+package sqltest
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files with the current output")
+
+// Golden checks a statement and its arguments against testdata/<name>.golden,
+// rewriting the file instead when the test runs with -update.
+func Golden(t *testing.T, name, sql string, args []any) {
+	t.Helper()
+
+	var b strings.Builder
+	b.WriteString(sql)
+	b.WriteString("\n")
+	for i, arg := range args {
+		fmt.Fprintf(&b, "-- $%d = %#v\n", i+1, arg)
+	}
+	got := b.String()
+
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.MkdirAll("testdata", 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v (run with -update to create it)", err)
+	}
+	if got != string(want) {
+		t.Errorf("%s does not match the generated SQL\ngot:\n%s\nwant:\n%s", path, got, want)
+	}
+}
@@ -0,0 +1,167 @@
+// Package store runs the database work of a service's repositories, inside a
+// transaction or straight on the pool.
+//
+// A transaction runs once unless the caller opts into retries with
+// MaxAttempts. Retried transactions that fail with a serialization failure or
+// a deadlock run again with backoff, so their function must not have effects
+// outside the transaction.
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/daffaromero/retries/services/common/utils/logger"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Store interface {
+	WithTx(ctx context.Context, fn func(pgx.Tx) error, opts ...TxOption) error
+	WithoutTx(ctx context.Context, fn func(*pgxpool.Pool) error) error
+}
+
+// DefaultMaxAttempts is how many times WithTx runs a transaction unless told
+// otherwise: once, since only the caller knows whether its function is safe
+// to run again.
+const DefaultMaxAttempts = 1
+
+const (
+	baseBackoff = 20 * time.Millisecond
+	maxBackoff  = 500 * time.Millisecond
+)
+
+type txConfig struct {
+	options     pgx.TxOptions
+	maxAttempts int
+}
+
+// TxOption configures a transaction started by WithTx.
+type TxOption func(*txConfig)
+
+// Isolation sets the isolation level. Postgres defaults to read committed.
+func Isolation(level pgx.TxIsoLevel) TxOption {
+	return func(c *txConfig) { c.options.IsoLevel = level }
+}
+
+// ReadOnly makes the transaction read-only.
+func ReadOnly() TxOption {
+	return func(c *txConfig) { c.options.AccessMode = pgx.ReadOnly }
+}
+
+// MaxAttempts sets how many times the transaction is run in all while it
+// fails with a retryable error. Only pass more than 1 when fn has no effects
+// outside the transaction.
+func MaxAttempts(n int) TxOption {
+	return func(c *txConfig) { c.maxAttempts = max(n, 1) }
+}
+
+type StoreImpl struct {
+	Db           *pgxpool.Pool
+	beginTimeout time.Duration
+	logger       *logger.Log
+}
+
+// New returns a Store on db. beginTimeout bounds how long starting a
+// transaction may wait for a connection; zero leaves it to the caller's
+// context.
+func New(db *pgxpool.Pool, beginTimeout time.Duration) Store {
+	return &StoreImpl{
+		Db:           db,
+		beginTimeout: beginTimeout,
+		logger:       logger.NewLog("database_store"),
+	}
+}
+
+// WithTx runs fn in a transaction, committing if it returns nil and rolling
+// back otherwise. With MaxAttempts the transaction is retried with backoff
+// while it fails with a serialization failure or deadlock and ctx allows.
+func (s *StoreImpl) WithTx(ctx context.Context, fn func(pgx.Tx) error, opts ...TxOption) error {
+	cfg := txConfig{maxAttempts: DefaultMaxAttempts}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return retry(ctx, cfg.maxAttempts, func() error {
+		return s.runTx(ctx, cfg.options, fn)
+	})
+}
+
+// retry calls run until it succeeds, fails with an error that is not
+// Retryable, has been called maxAttempts times or ctx is done, and returns
+// its last error.
+func retry(ctx context.Context, maxAttempts int, run func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := run()
+		if err == nil || attempt >= maxAttempts || !Retryable(err) {
+			return err
+		}
+		select {
+		case <-time.After(backoff(attempt)):
+		case <-ctx.Done():
+			return err
+		}
+	}
+}
+
+func (s *StoreImpl) runTx(ctx context.Context, options pgx.TxOptions, fn func(pgx.Tx) error) error {
+	tx, err := s.begin(ctx, options)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	if err := fn(tx); err != nil {
+		// Roll back even when ctx is done, so the connection goes back to
+		// the pool clean.
+		if rollbackErr := tx.Rollback(context.WithoutCancel(ctx)); rollbackErr != nil {
+			s.logger.Error(fmt.Sprintf("Rollback error: %v, original error: %v", rollbackErr, err))
+			return fmt.Errorf("%w (rollback failed: %v)", err, rollbackErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (s *StoreImpl) begin(ctx context.Context, options pgx.TxOptions) (pgx.Tx, error) {
+	if s.beginTimeout <= 0 {
+		return s.Db.BeginTx(ctx, options)
+	}
+	// Canceling this context after Begin returns does not affect the
+	// transaction, which runs on ctx from then on.
+	c, cancel := context.WithTimeout(ctx, s.beginTimeout)
+	defer cancel()
+	return s.Db.BeginTx(c, options)
+}
+
+func (s *StoreImpl) WithoutTx(ctx context.Context, fn func(*pgxpool.Pool) error) error {
+	return fn(s.Db)
+}
+
+// Retryable reports whether err is a serialization failure or a deadlock,
+// after which running the transaction again may succeed.
+func Retryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return false
+	}
+	return pgErr.Code == "40001" || pgErr.Code == "40P01"
+}
+
+// backoff returns a jittered wait before the attempt after attempt, doubling
+// from baseBackoff up to maxBackoff.
+func backoff(attempt int) time.Duration {
+	d := baseBackoff
+	for i := 1; i < attempt && d < maxBackoff; i++ {
+		d *= 2
+	}
+	d = min(d, maxBackoff)
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
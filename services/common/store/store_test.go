@@ -0,0 +1,94 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func TestRetryable(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{&pgconn.PgError{Code: "40001"}, true},
+		{&pgconn.PgError{Code: "40P01"}, true},
+		{fmt.Errorf("failed to commit transaction: %w", &pgconn.PgError{Code: "40001"}), true},
+		{&pgconn.PgError{Code: "23505"}, false},
+		{errors.New("40001"), false},
+		{nil, false},
+	}
+	for _, tt := range tests {
+		if got := Retryable(tt.err); got != tt.want {
+			t.Errorf("Retryable(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	for attempt := 1; attempt <= 100; attempt++ {
+		d := backoff(attempt)
+		if d <= 0 || d > maxBackoff {
+			t.Fatalf("backoff(%d) = %v, want within (0, %v]", attempt, d, maxBackoff)
+		}
+	}
+	if d := backoff(1); d > baseBackoff {
+		t.Errorf("backoff(1) = %v, want at most %v", d, baseBackoff)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	serialization := fmt.Errorf("failed to commit transaction: %w", &pgconn.PgError{Code: "40001"})
+	unique := &pgconn.PgError{Code: "23505"}
+
+	tests := []struct {
+		name        string
+		maxAttempts int
+		errs        []error
+		wantCalls   int
+		wantErr     error
+	}{
+		{name: "success", maxAttempts: 3, errs: []error{nil}, wantCalls: 1},
+		{name: "retried until success", maxAttempts: 3, errs: []error{serialization, serialization, nil}, wantCalls: 3},
+		{name: "gives up after max attempts", maxAttempts: 3, errs: []error{serialization, serialization, serialization, nil}, wantCalls: 3, wantErr: serialization},
+		{name: "not retried by default", maxAttempts: DefaultMaxAttempts, errs: []error{serialization, nil}, wantCalls: 1, wantErr: serialization},
+		{name: "error that is not retryable", maxAttempts: 3, errs: []error{unique, nil}, wantCalls: 1, wantErr: unique},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			err := retry(context.Background(), tt.maxAttempts, func() error {
+				calls++
+				return tt.errs[calls-1]
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("retry = %v, want %v", err, tt.wantErr)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("run called %d times, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRetryStopsWithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := 0
+	start := time.Now()
+	err := retry(ctx, 100, func() error {
+		calls++
+		return &pgconn.PgError{Code: "40P01"}
+	})
+	if !Retryable(err) || calls != 1 {
+		t.Errorf("retry on a done ctx = %v after %d calls, want the deadlock after 1", err, calls)
+	}
+	if elapsed := time.Since(start); elapsed > baseBackoff {
+		t.Errorf("retry waited %s on a done ctx", elapsed)
+	}
+}
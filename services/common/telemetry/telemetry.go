@@ -0,0 +1,54 @@
+// Package telemetry sets up the OpenTelemetry meter provider of a service.
+// Instruments created through the global otel API, such as the gRPC stats
+// handlers and the resilience package's attempt counter, are served in the
+// Prometheus text format for scraping.
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelprom "go.opentelemetry.io/otel/exporters/prometheus"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// Metrics is the process's meter provider and the handler that exposes it.
+type Metrics struct {
+	provider *sdkmetric.MeterProvider
+	handler  http.Handler
+}
+
+// NewMetrics installs a meter provider for service as the global one. Call it
+// early in main: instruments created before it record nothing until then.
+func NewMetrics(service string) (*Metrics, error) {
+	registry := prometheus.NewRegistry()
+	exporter, err := otelprom.New(otelprom.WithRegisterer(registry))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create metrics exporter: %w", err)
+	}
+	provider := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(exporter),
+		sdkmetric.WithResource(resource.NewSchemaless(attribute.String("service.name", service))),
+	)
+	otel.SetMeterProvider(provider)
+	return &Metrics{
+		provider: provider,
+		handler:  promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
+	}, nil
+}
+
+// Handler serves the current metrics, typically on GET /metrics.
+func (m *Metrics) Handler() http.Handler {
+	return m.handler
+}
+
+// Shutdown stops the meter provider; instruments record nothing afterwards.
+func (m *Metrics) Shutdown(ctx context.Context) error {
+	return m.provider.Shutdown(ctx)
+}
@@ -1,8 +1,10 @@
 package logger
 
 import (
+	"errors"
 	"log"
 	"os"
+	"syscall"
 )
 
 type Log struct {
@@ -22,3 +24,14 @@ func NewLog(prefix string) *Log {
 		err: log.New(os.Stderr, "[ERROR]["+prefix+"]", log.Default().Flags()),
 	}
 }
+
+// Sync flushes stdout and stderr to disk when they are redirected to files.
+// It is a no-op for terminals and pipes, which cannot be synced.
+func (l *Log) Sync() error {
+	for _, f := range []*os.File{os.Stdout, os.Stderr} {
+		if err := f.Sync(); err != nil && !errors.Is(err, syscall.EINVAL) && !errors.Is(err, syscall.ENOTSUP) {
+			return err
+		}
+	}
+	return nil
+}
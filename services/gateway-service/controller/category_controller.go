@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"net/http"
+
+	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	"github.com/daffaromero/retries/services/common/utils"
+)
+
+func (g *gateway) CreateCategory(w http.ResponseWriter, r *http.Request) {
+	var req pb.Category
+	if err := utils.ParseJSON(r, &req); err != nil {
+		utils.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	client, err := g.productClient(r)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	res, err := client.CreateCategory(r.Context(), &req)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusCreated, res)
+}
+
+func (g *gateway) GetCategoryByID(w http.ResponseWriter, r *http.Request) {
+	req := &pb.GetCategoryFilter{Id: r.PathValue("id")}
+
+	client, err := g.productClient(r)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	res, err := client.GetCategoryByID(r.Context(), req)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, res)
+}
+
+func (g *gateway) GetCategories(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	req := &pb.GetCategoryFilter{
+		Search: q.Get("search"),
+		Pagination: &pb.Pagination{
+			Page:      queryInt32(r, "page"),
+			Limit:     queryInt32(r, "limit"),
+			Offset:    queryInt32(r, "offset"),
+			Cursor:    q.Get("cursor"),
+			WithTotal: q.Get("with_total") == "true",
+		},
+		Sorting: &pb.Sorting{
+			OrderBy:    q.Get("order_by"),
+			IsReversed: q.Get("reversed") == "true",
+		},
+	}
+
+	client, err := g.productClient(r)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	res, err := client.GetCategories(r.Context(), req)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, res)
+}
+
+func (g *gateway) UpdateCategory(w http.ResponseWriter, r *http.Request) {
+	var req pb.Category
+	if err := utils.ParseJSON(r, &req); err != nil {
+		utils.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+	req.Id = r.PathValue("id")
+
+	client, err := g.productClient(r)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	res, err := client.UpdateCategory(r.Context(), &req)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, res)
+}
+
+func (g *gateway) DeleteCategory(w http.ResponseWriter, r *http.Request) {
+	req := &pb.GetCategoryFilter{Id: r.PathValue("id")}
+
+	client, err := g.productClient(r)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	res, err := client.DeleteCategory(r.Context(), req)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, res)
+}
@@ -1,11 +1,147 @@
 package controller
 
-import "github.com/daffaromero/retries/services/common/discovery"
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/daffaromero/retries/services/common/discovery"
+	"github.com/daffaromero/retries/services/common/resilience"
+	"github.com/daffaromero/retries/services/common/utils"
+	"github.com/daffaromero/retries/services/common/utils/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Gateway interface {
+	Route(*http.ServeMux)
+	Close() error
+}
 
 type gateway struct {
 	registry discovery.Registry
+	logger   *logger.Log
+
+	mu    sync.Mutex
+	conns map[string]*grpc.ClientConn
+}
+
+func NewGRPCGateway(registry discovery.Registry) Gateway {
+	return &gateway{
+		registry: registry,
+		logger:   logger.NewLog("gateway"),
+		conns:    map[string]*grpc.ClientConn{},
+	}
+}
+
+func (g *gateway) Route(mux *http.ServeMux) {
+	mux.HandleFunc("POST /orders", g.CreateOrder)
+	mux.HandleFunc("GET /orders", g.GetOrders)
+	mux.HandleFunc("GET /orders/{id}", g.GetOrder)
+	mux.HandleFunc("PUT /orders/{id}", g.UpdateOrder)
+	mux.HandleFunc("POST /orders/{id}/send", g.SendOrder)
+	mux.HandleFunc("GET /orders/{id}/history", g.GetOrderHistory)
+	mux.HandleFunc("POST /orders/{id}/cancel", g.CancelOrder)
+	mux.HandleFunc("POST /orders/{id}/refund", g.RefundOrder)
+
+	mux.HandleFunc("POST /products", g.CreateProduct)
+	mux.HandleFunc("GET /products", g.GetProducts)
+	mux.HandleFunc("GET /products/search", g.SearchProducts)
+	mux.HandleFunc("GET /products/{id}", g.GetProductByID)
+	mux.HandleFunc("PUT /products/{id}", g.UpdateProduct)
+	mux.HandleFunc("POST /products/{id}/approve", g.ApproveProduct)
+
+	mux.HandleFunc("POST /categories", g.CreateCategory)
+	mux.HandleFunc("GET /categories", g.GetCategories)
+	mux.HandleFunc("GET /categories/{id}", g.GetCategoryByID)
+	mux.HandleFunc("PUT /categories/{id}", g.UpdateCategory)
+	mux.HandleFunc("DELETE /categories/{id}", g.DeleteCategory)
+
+	mux.HandleFunc("POST /payments", g.CreatePayment)
+	mux.HandleFunc("GET /payments", g.ListPayments)
+	mux.HandleFunc("GET /payments/{id}", g.GetPayment)
+
+	mux.Handle("GET /debug/breakers", resilience.DebugHandler())
+}
+
+func (g *gateway) Close() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	var errs []error
+	for name, conn := range g.conns {
+		if err := conn.Close(); err != nil {
+			errs = append(errs, err)
+		}
+		delete(g.conns, name)
+	}
+	return errors.Join(errs...)
+}
+
+// conn returns a client connection to serviceName, dialing it through the
+// registry on first use so the gateway can start before its backends.
+func (g *gateway) conn(ctx context.Context, serviceName string) (*grpc.ClientConn, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if conn, ok := g.conns[serviceName]; ok {
+		return conn, nil
+	}
+
+	conn, err := discovery.ConnectToService(ctx, serviceName, g.registry)
+	if err != nil {
+		g.logger.CustomError("Failed to connect to "+serviceName, err)
+		return nil, status.Errorf(codes.Unavailable, "%s is unavailable", serviceName)
+	}
+	g.conns[serviceName] = conn
+	return conn, nil
+}
+
+func (g *gateway) writeError(w http.ResponseWriter, err error) {
+	st, ok := status.FromError(err)
+	if !ok {
+		g.logger.Error(err)
+		utils.WriteError(w, http.StatusInternalServerError, errors.New("internal server error"))
+		return
+	}
+	utils.WriteError(w, httpStatus(st.Code()), errors.New(st.Message()))
+}
+
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	// Services report lifecycle conflicts, such as cancelling a paid order,
+	// as FailedPrecondition and answer them with a 409 over their own REST.
+	case codes.AlreadyExists, codes.Aborted, codes.FailedPrecondition:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Canceled:
+		return 499
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
 }
 
-func NewGRPCGateway(registry discovery.Registry) *gateway {
-	return &gateway{registry}
+func queryInt32(r *http.Request, key string) int32 {
+	v, _ := strconv.Atoi(r.URL.Query().Get(key))
+	return int32(v)
 }
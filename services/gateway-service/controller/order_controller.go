@@ -0,0 +1,206 @@
+package controller
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/daffaromero/retries/services/common/discovery"
+	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	"github.com/daffaromero/retries/services/common/utils"
+)
+
+func (g *gateway) orderClient(r *http.Request) (pb.OrderServiceClient, error) {
+	conn, err := g.conn(r.Context(), discovery.OrderServiceName)
+	if err != nil {
+		return nil, err
+	}
+	return pb.NewOrderServiceClient(conn), nil
+}
+
+func (g *gateway) CreateOrder(w http.ResponseWriter, r *http.Request) {
+	var req pb.Order
+	if err := utils.ParseJSON(r, &req); err != nil {
+		utils.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	client, err := g.orderClient(r)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	res, err := client.CreateOrder(r.Context(), &req)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusCreated, res)
+}
+
+func (g *gateway) GetOrder(w http.ResponseWriter, r *http.Request) {
+	req := &pb.GetOrderFilter{
+		OrderId:    r.PathValue("id"),
+		CustomerId: r.URL.Query().Get("customer_id"),
+	}
+
+	client, err := g.orderClient(r)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	res, err := client.GetOrder(r.Context(), req)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, res)
+}
+
+func (g *gateway) GetOrders(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	req := &pb.GetOrdersRequest{
+		CustomerId: q.Get("customer_id"),
+		Search:     q.Get("search"),
+		Pagination: &pb.Pagination{
+			Page:      queryInt32(r, "page"),
+			Limit:     queryInt32(r, "limit"),
+			Offset:    queryInt32(r, "offset"),
+			Cursor:    q.Get("cursor"),
+			WithTotal: q.Get("with_total") == "true",
+		},
+		Sorting: &pb.Sorting{
+			OrderBy:    q.Get("order_by"),
+			IsReversed: q.Get("reversed") == "true",
+		},
+	}
+
+	client, err := g.orderClient(r)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	stream, err := client.GetOrders(r.Context(), req)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+
+	res := &pb.GetOrderResponse{}
+	for {
+		page, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			g.writeError(w, err)
+			return
+		}
+		res.Orders = append(res.Orders, page.Orders...)
+		if page.NextCursor != "" {
+			res.NextCursor = page.NextCursor
+		}
+		if page.Total != 0 {
+			res.Total = page.Total
+		}
+	}
+	utils.WriteJSON(w, http.StatusOK, res)
+}
+
+func (g *gateway) UpdateOrder(w http.ResponseWriter, r *http.Request) {
+	var req pb.Order
+	if err := utils.ParseJSON(r, &req); err != nil {
+		utils.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+	req.Id = r.PathValue("id")
+
+	client, err := g.orderClient(r)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	res, err := client.UpdateOrder(r.Context(), &req)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, res)
+}
+
+func (g *gateway) SendOrder(w http.ResponseWriter, r *http.Request) {
+	req := &pb.SendOrderRequest{OrderId: r.PathValue("id")}
+
+	client, err := g.orderClient(r)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	res, err := client.SendOrder(r.Context(), req)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, res)
+}
+
+func (g *gateway) GetOrderHistory(w http.ResponseWriter, r *http.Request) {
+	req := &pb.GetOrderHistoryRequest{OrderId: r.PathValue("id")}
+
+	client, err := g.orderClient(r)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	res, err := client.GetOrderHistory(r.Context(), req)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, res)
+}
+
+func (g *gateway) CancelOrder(w http.ResponseWriter, r *http.Request) {
+	var req pb.CancelOrderRequest
+	if r.ContentLength != 0 {
+		if err := utils.ParseJSON(r, &req); err != nil {
+			utils.WriteError(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+	req.OrderId = r.PathValue("id")
+
+	client, err := g.orderClient(r)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	res, err := client.CancelOrder(r.Context(), &req)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, res)
+}
+
+func (g *gateway) RefundOrder(w http.ResponseWriter, r *http.Request) {
+	var req pb.RefundOrderRequest
+	if r.ContentLength != 0 {
+		if err := utils.ParseJSON(r, &req); err != nil {
+			utils.WriteError(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+	req.OrderId = r.PathValue("id")
+
+	client, err := g.orderClient(r)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	res, err := client.RefundOrder(r.Context(), &req)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, res)
+}
@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/daffaromero/retries/services/common/discovery"
+	pbPay "github.com/daffaromero/retries/services/common/genproto/payment"
+	"github.com/daffaromero/retries/services/common/utils"
+)
+
+func (g *gateway) paymentClient(r *http.Request) (pbPay.PaymentServiceClient, error) {
+	conn, err := g.conn(r.Context(), discovery.PaymentServiceName)
+	if err != nil {
+		return nil, err
+	}
+	return pbPay.NewPaymentServiceClient(conn), nil
+}
+
+func (g *gateway) CreatePayment(w http.ResponseWriter, r *http.Request) {
+	var req pbPay.CreatePaymentRequest
+	if err := utils.ParseJSON(r, &req); err != nil {
+		utils.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	client, err := g.paymentClient(r)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	res, err := client.CreatePayment(r.Context(), &req)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusCreated, res)
+}
+
+func (g *gateway) GetPayment(w http.ResponseWriter, r *http.Request) {
+	req := &pbPay.GetPaymentRequest{Id: r.PathValue("id")}
+
+	client, err := g.paymentClient(r)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	res, err := client.GetPayment(r.Context(), req)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, res)
+}
+
+func (g *gateway) ListPayments(w http.ResponseWriter, r *http.Request) {
+	req := &pbPay.ListPaymentsRequest{
+		CustomerId: r.URL.Query().Get("customer_id"),
+		Count:      queryInt32(r, "count"),
+		Start:      queryInt32(r, "start"),
+	}
+
+	client, err := g.paymentClient(r)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	res, err := client.ListPayments(r.Context(), req)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, res)
+}
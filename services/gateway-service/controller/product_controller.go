@@ -0,0 +1,159 @@
+package controller
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/daffaromero/retries/services/common/discovery"
+	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	"github.com/daffaromero/retries/services/common/utils"
+)
+
+func (g *gateway) productClient(r *http.Request) (pb.ProductServiceClient, error) {
+	conn, err := g.conn(r.Context(), discovery.ProductServiceName)
+	if err != nil {
+		return nil, err
+	}
+	return pb.NewProductServiceClient(conn), nil
+}
+
+func (g *gateway) CreateProduct(w http.ResponseWriter, r *http.Request) {
+	var req pb.Product
+	if err := utils.ParseJSON(r, &req); err != nil {
+		utils.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	client, err := g.productClient(r)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	res, err := client.CreateProduct(r.Context(), &req)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusCreated, res)
+}
+
+func (g *gateway) GetProductByID(w http.ResponseWriter, r *http.Request) {
+	req := &pb.GetProductFilter{Id: r.PathValue("id")}
+
+	client, err := g.productClient(r)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	res, err := client.GetProductByID(r.Context(), req)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, res)
+}
+
+// productFilter reads the product listing filters from r's query string.
+func productFilter(r *http.Request) *pb.GetProductFilter {
+	q := r.URL.Query()
+	req := &pb.GetProductFilter{
+		SellerId:        q.Get("seller_id"),
+		VisEarly:        q.Get("vis_early"),
+		VisLate:         q.Get("vis_late"),
+		InvisEarly:      q.Get("invis_early"),
+		InvisLate:       q.Get("invis_late"),
+		Visibility:      q.Get("visibility"),
+		Exclusion:       q.Get("exclusion"),
+		IsAdminVerified: q.Get("is_admin_verified"),
+		LowestPrice:     queryInt32(r, "lowest_price"),
+		HighestPrice:    queryInt32(r, "highest_price"),
+		Search:          q.Get("search"),
+		Pagination: &pb.Pagination{
+			Page:      queryInt32(r, "page"),
+			Limit:     queryInt32(r, "limit"),
+			Offset:    queryInt32(r, "offset"),
+			Cursor:    q.Get("cursor"),
+			WithTotal: q.Get("with_total") == "true",
+		},
+		Sorting: &pb.Sorting{
+			OrderBy:    q.Get("order_by"),
+			IsReversed: q.Get("reversed") == "true",
+		},
+	}
+	if categories := q.Get("categories"); categories != "" {
+		for _, name := range strings.Split(categories, ",") {
+			req.Categories = append(req.Categories, &pb.Category{Name: strings.TrimSpace(name)})
+		}
+	}
+	return req
+}
+
+func (g *gateway) GetProducts(w http.ResponseWriter, r *http.Request) {
+	client, err := g.productClient(r)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	res, err := client.GetProducts(r.Context(), productFilter(r))
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, res)
+}
+
+func (g *gateway) SearchProducts(w http.ResponseWriter, r *http.Request) {
+	client, err := g.productClient(r)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	res, err := client.SearchProducts(r.Context(), productFilter(r))
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, res)
+}
+
+func (g *gateway) UpdateProduct(w http.ResponseWriter, r *http.Request) {
+	var req pb.Product
+	if err := utils.ParseJSON(r, &req); err != nil {
+		utils.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+	req.Id = r.PathValue("id")
+
+	client, err := g.productClient(r)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	res, err := client.UpdateProduct(r.Context(), &req)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, res)
+}
+
+func (g *gateway) ApproveProduct(w http.ResponseWriter, r *http.Request) {
+	var req pb.ApproveProductRequest
+	if err := utils.ParseJSON(r, &req); err != nil {
+		utils.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+	req.Id = r.PathValue("id")
+
+	client, err := g.productClient(r)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	res, err := client.ApproveProduct(r.Context(), &req)
+	if err != nil {
+		g.writeError(w, err)
+		return
+	}
+	utils.WriteJSON(w, http.StatusOK, res)
+}
@@ -1,6 +1,66 @@
 package main
 
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/daffaromero/retries/services/common/discovery"
+	"github.com/daffaromero/retries/services/common/discovery/backend"
+	"github.com/daffaromero/retries/services/common/health"
+	"github.com/daffaromero/retries/services/common/shutdown"
+	"github.com/daffaromero/retries/services/common/telemetry"
+	"github.com/daffaromero/retries/services/gateway-service/config"
+	"github.com/daffaromero/retries/services/gateway-service/controller"
+)
+
 func main() {
-	// mux := http.NewServeMux()
-	// mux.HandleFunc("POST /")
+	serverConfig := config.NewServerConfig()
+	shutdownTimeout, err := shutdown.TimeoutFromEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	metrics, err := telemetry.NewMetrics("gateway-service")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	registry, err := backend.NewFromEnv()
+	if err != nil {
+		log.Fatalf("failed to create service registry: %v", err)
+	}
+
+	gateway := controller.NewGRPCGateway(registry)
+
+	checker := health.NewChecker("gateway-service")
+	checker.Add("downstream", discovery.CheckClients)
+	go checker.Run(context.Background())
+
+	mux := http.NewServeMux()
+	gateway.Route(mux)
+	mux.Handle("GET /healthz", checker.LiveHandler())
+	mux.Handle("GET /readyz", checker.ReadyHandler())
+	mux.Handle("GET /metrics", metrics.Handler())
+
+	srv := &http.Server{Addr: serverConfig.Host, Handler: mux}
+	go func() {
+		log.Printf("gateway listening on %s", serverConfig.Host)
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("gateway failed: %v", err)
+		}
+	}()
+
+	stop := shutdown.New(shutdownTimeout)
+	stop.Add("mark not ready", checker.Shutdown)
+	stop.Add("drain server", func(ctx context.Context) error {
+		if err := srv.Shutdown(ctx); err != nil {
+			srv.Close()
+			return err
+		}
+		return nil
+	})
+	stop.Add("close clients", func(context.Context) error { return gateway.Close() })
+	stop.Add("stop metrics", metrics.Shutdown)
+	stop.Wait()
 }
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"strconv"
+
+	"github.com/daffaromero/retries/services/common/utils"
+)
+
+// PricingConfig holds the order minimum and the admin fee policy. The fee is
+// AdminFeePercent of the subtotal kept within AdminFeeMin and AdminFeeMax (no
+// cap when zero), plus AdminFeeFlat on every order.
+type PricingConfig struct {
+	MinimumSubtotal int32
+	AdminFeePercent float64
+	AdminFeeMin     int32
+	AdminFeeMax     int32
+	AdminFeeFlat    int32
+}
+
+func NewPricingConfig() PricingConfig {
+	return PricingConfig{
+		MinimumSubtotal: int32(envInt("ORDER_MINIMUM_SUBTOTAL", 5)),
+		AdminFeePercent: envFloat("ADMIN_FEE_PERCENT", 2),
+		AdminFeeMin:     int32(envInt("ADMIN_FEE_MIN", 0)),
+		AdminFeeMax:     int32(envInt("ADMIN_FEE_MAX", 0)),
+		AdminFeeFlat:    int32(envInt("ADMIN_FEE_FLAT", 0)),
+	}
+}
+
+func envInt(key string, def int) int {
+	v, err := strconv.Atoi(utils.GetEnv(key))
+	if err != nil {
+		return def
+	}
+	return v
+}
+
+func envFloat(key string, def float64) float64 {
+	v, err := strconv.ParseFloat(utils.GetEnv(key), 64)
+	if err != nil {
+		return def
+	}
+	return v
+}
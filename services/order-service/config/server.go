@@ -6,18 +6,24 @@ import (
 	"github.com/daffaromero/retries/services/common/utils"
 )
 
-var EndpointPrefix = utils.GetEnv("ENDPOINT_PREFIX")
+var (
+	EndpointPrefix = utils.GetEnv("ENDPOINT_PREFIX")
+)
 
 type serverConfig struct {
-	URI  string
-	Port string
-	Host string
+	URI      string
+	Port     string
+	Host     string
+	GRPCPort string
+	GRPCHost string
 }
 
 func NewServerConfig() serverConfig {
 	return serverConfig{
-		URI:  utils.GetEnv("SERVER_URI"),
-		Port: utils.GetEnv("SERVER_PORT"),
-		Host: fmt.Sprintf("%s:%s", utils.GetEnv("SERVER_URI"), utils.GetEnv("SERVER_PORT")),
+		URI:      utils.GetEnv("SERVER_URI"),
+		Port:     utils.GetEnv("SERVER_PORT"),
+		Host:     fmt.Sprintf("%s:%s", utils.GetEnv("SERVER_URI"), utils.GetEnv("SERVER_PORT")),
+		GRPCPort: utils.GetEnv("GRPC_PORT"),
+		GRPCHost: fmt.Sprintf("%s:%s", utils.GetEnv("SERVER_URI"), utils.GetEnv("GRPC_PORT")),
 	}
 }
@@ -1,18 +1,17 @@
 package controller
 
 import (
-	"bufio"
-	"encoding/json"
-	"fmt"
-	"io"
-	"log"
+	"errors"
+	"math"
 	"strconv"
 
-	pb "github.com/daffaromero/retries/services/common/genproto/orders"
+	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	"github.com/daffaromero/retries/services/common/resilience"
 	"github.com/daffaromero/retries/services/order-service/config"
 	"github.com/daffaromero/retries/services/order-service/service"
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v3"
+	"github.com/jackc/pgx/v5"
 )
 
 type OrderController interface {
@@ -20,6 +19,9 @@ type OrderController interface {
 	CreateOrder(fiber.Ctx) error
 	GetOrder(fiber.Ctx) error
 	GetAllOrders(fiber.Ctx) error
+	GetOrderHistory(fiber.Ctx) error
+	CancelOrder(fiber.Ctx) error
+	RefundOrder(fiber.Ctx) error
 }
 
 type orderController struct {
@@ -39,34 +41,39 @@ func (o *orderController) Route(app *fiber.App) {
 	api.Post("/new", o.CreateOrder)
 	api.Get("/customer", o.GetOrder)
 	api.Get("/all", o.GetAllOrders)
+	api.Get("/:id/history", o.GetOrderHistory)
+	api.Post("/:id/cancel", o.CancelOrder)
+	api.Post("/:id/refund", o.RefundOrder)
 }
 
 func (o *orderController) CreateOrder(c fiber.Ctx) error {
-	var req *pb.CreateOrderRequest
+	var req pb.Order
 	err := c.Bind().Body(&req)
 	if err != nil {
 		return fiber.ErrBadRequest
 	}
+	if req.CustomerId == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "customer_id not provided"})
+	}
 
-	ord, err := o.orderService.CreateOrder(c.Context(), req, req.CustomerId, req.ProductId, req.Quantity)
+	ord, err := o.orderService.CreateOrder(c.Context(), &req)
 	if err != nil {
-		return fiber.ErrInternalServerError
+		return orderError(c, err, "failed to create order")
 	}
-	res := &pb.CreateOrderResponse{
-		Id:     ord.Id,
-		Status: true,
-	}
-	return c.Status(fiber.StatusOK).JSON(res)
+	return c.Status(fiber.StatusOK).JSON(ord)
 }
 
 func (o *orderController) GetOrder(c fiber.Ctx) error {
 	var req pb.GetOrderFilter
 	req.CustomerId = c.Query("customer_id")
-	if req.CustomerId == "" {
+	req.OrderId = c.Query("order_id")
+	if req.CustomerId == "" && req.OrderId == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "customer_id not provided"})
 	}
-	ord, err := o.orderService.GetOrder(c.Context(), &req)
-	if err != nil {
+	ord, err := o.orderService.GetOrderDetails(c.Context(), &req)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "no orders found"})
+	} else if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to get order for customer"})
 	}
 	res := &pb.GetOrderResponse{
@@ -77,45 +84,96 @@ func (o *orderController) GetOrder(c fiber.Ctx) error {
 
 func (o *orderController) GetAllOrders(c fiber.Ctx) error {
 	var req pb.GetOrdersRequest
-	err := c.Bind().Body(&req)
+	req.CustomerId = c.Query("customer_id")
+	req.Search = c.Query("search")
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	offset, _ := strconv.Atoi(c.Query("offset"))
+	page, _ := strconv.Atoi(c.Query("page"))
+	req.Pagination = &pb.Pagination{
+		Page:      int32(page),
+		Limit:     int32(limit),
+		Offset:    int32(offset),
+		Cursor:    c.Query("cursor"),
+		WithTotal: c.Query("with_total") == "true",
+	}
+	req.Sorting = &pb.Sorting{
+		OrderBy:    c.Query("order_by"),
+		IsReversed: c.Query("reversed") == "true",
+	}
+
+	ords, err := o.orderService.GetAllOrders(c.Context(), &req)
 	if err != nil {
-		return fmt.Errorf("error binding request - %s", err)
+		var fe *fiber.Error
+		if errors.As(err, &fe) && fe.Code == fiber.StatusBadRequest {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": fe.Message})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to get orders"})
 	}
-	req.CustomerId = c.Query("customer_id")
-	count, _ := strconv.Atoi(c.Query("count"))
-	start, _ := strconv.Atoi(c.Query("start"))
-	req.Count = int32(count)
-	req.Start = int32(start)
+	return c.Status(fiber.StatusOK).JSON(ords)
+}
+
+func (o *orderController) GetOrderHistory(c fiber.Ctx) error {
+	req := pb.GetOrderHistoryRequest{OrderId: c.Params("id")}
+	if req.OrderId == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "order_id not provided"})
+	}
+	res, err := o.orderService.GetOrderHistory(c.Context(), &req)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "order not found"})
+	} else if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to get order history"})
+	}
+	return c.Status(fiber.StatusOK).JSON(res)
+}
 
-	grpcServer := NewRestOrderServer()
+func (o *orderController) CancelOrder(c fiber.Ctx) error {
+	var req pb.CancelOrderRequest
+	if len(c.Body()) > 0 {
+		if err := c.Bind().Body(&req); err != nil {
+			return fiber.ErrBadRequest
+		}
+	}
+	req.OrderId = c.Params("id")
 
-	go func() {
-		if err := o.orderService.GetAllOrders(c.Context(), &req, grpcServer); err != nil {
-			log.Printf("error getting orders: %v", err)
+	ord, err := o.orderService.CancelOrder(c.Context(), &req)
+	if err != nil {
+		return orderError(c, err, "failed to cancel order")
+	}
+	return c.Status(fiber.StatusOK).JSON(ord)
+}
+
+func (o *orderController) RefundOrder(c fiber.Ctx) error {
+	var req pb.RefundOrderRequest
+	if len(c.Body()) > 0 {
+		if err := c.Bind().Body(&req); err != nil {
+			return fiber.ErrBadRequest
 		}
-		close(grpcServer.results)
-	}()
-
-	c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSONCharsetUTF8)
-	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
-		enc := json.NewEncoder(w)
-		for {
-			res, err := grpcServer.Recv()
-			if err == io.EOF {
-				break
-			}
-			if err != nil {
-				log.Printf("error receiving order response: %v", err)
-				c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to get orders"})
-				return
-			}
-			if err := enc.Encode(res); err != nil {
-				log.Printf("error encoding order response: %v", err)
-				c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to encode orders"})
-				return
-			}
-			w.Flush()
+	}
+	req.OrderId = c.Params("id")
+
+	res, err := o.orderService.RefundOrder(c.Context(), &req)
+	if err != nil {
+		return orderError(c, err, "failed to refund order")
+	}
+	return c.Status(fiber.StatusOK).JSON(res)
+}
+
+// orderError writes the status carried by a service error, falling back to
+// msg for anything unexpected.
+func orderError(c fiber.Ctx, err error, msg string) error {
+	var ue *resilience.UnavailableError
+	if errors.As(err, &ue) {
+		if ue.RetryAfter > 0 {
+			c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(ue.RetryAfter.Seconds()))))
 		}
-	})
-	return nil
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{"error": "a downstream service is unavailable, please try again later"})
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "order not found"})
+	}
+	var fe *fiber.Error
+	if errors.As(err, &fe) {
+		return c.Status(fe.Code).JSON(fiber.Map{"error": fe.Message})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": msg})
 }
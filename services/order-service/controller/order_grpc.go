@@ -0,0 +1,163 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	"github.com/daffaromero/retries/services/common/resilience"
+	"github.com/daffaromero/retries/services/order-service/service"
+	"github.com/gofiber/fiber/v3"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type orderGRPCServer struct {
+	pb.UnimplementedOrderServiceServer
+	orderService service.OrderService
+}
+
+func NewOrderGRPCServer(ordServ service.OrderService) pb.OrderServiceServer {
+	return &orderGRPCServer{orderService: ordServ}
+}
+
+func (o *orderGRPCServer) CreateOrder(ctx context.Context, req *pb.Order) (*pb.Order, error) {
+	if req.CustomerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "customer_id not provided")
+	}
+	ord, err := o.orderService.CreateOrder(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return ord, nil
+}
+
+func (o *orderGRPCServer) GetOrder(ctx context.Context, req *pb.GetOrderFilter) (*pb.GetOrderResponse, error) {
+	if req.OrderId == "" && req.CustomerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "order_id or customer_id not provided")
+	}
+	res, err := o.orderService.GetOrderDetails(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
+
+func (o *orderGRPCServer) GetOrders(req *pb.GetOrdersRequest, stream pb.OrderService_GetOrdersServer) error {
+	res, err := o.orderService.GetAllOrders(stream.Context(), req)
+	if err != nil {
+		return toStatus(err)
+	}
+	for _, ord := range res.Orders {
+		if err := stream.Send(&pb.GetOrderResponse{Orders: []*pb.Order{ord}}); err != nil {
+			return err
+		}
+	}
+	// The cursor and total describe the whole listing, so they follow the
+	// orders in a message of their own.
+	if res.NextCursor != "" || res.Total != 0 {
+		return stream.Send(&pb.GetOrderResponse{NextCursor: res.NextCursor, Total: res.Total})
+	}
+	return nil
+}
+
+func (o *orderGRPCServer) UpdateOrder(ctx context.Context, req *pb.Order) (*pb.Order, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id not provided")
+	}
+	ord, err := o.orderService.UpdateOrder(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return ord, nil
+}
+
+func (o *orderGRPCServer) SendOrder(ctx context.Context, req *pb.SendOrderRequest) (*pb.SendOrderResponse, error) {
+	if req.OrderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "order_id not provided")
+	}
+	res, err := o.orderService.SendOrder(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
+
+func (o *orderGRPCServer) SettleOrder(ctx context.Context, req *pb.SettleOrderRequest) (*pb.Order, error) {
+	if req.OrderId == "" || req.SettlementStatus == "" {
+		return nil, status.Error(codes.InvalidArgument, "order_id or settlement_status not provided")
+	}
+	ord, err := o.orderService.SettleOrder(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return ord, nil
+}
+
+func (o *orderGRPCServer) GetOrderHistory(ctx context.Context, req *pb.GetOrderHistoryRequest) (*pb.GetOrderHistoryResponse, error) {
+	if req.OrderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "order_id not provided")
+	}
+	res, err := o.orderService.GetOrderHistory(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
+
+func (o *orderGRPCServer) CancelOrder(ctx context.Context, req *pb.CancelOrderRequest) (*pb.Order, error) {
+	if req.OrderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "order_id not provided")
+	}
+	ord, err := o.orderService.CancelOrder(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return ord, nil
+}
+
+func (o *orderGRPCServer) RefundOrder(ctx context.Context, req *pb.RefundOrderRequest) (*pb.RefundOrderResponse, error) {
+	if req.OrderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "order_id not provided")
+	}
+	res, err := o.orderService.RefundOrder(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
+
+// toStatus maps an order service error onto a gRPC status. Fiber errors keep
+// their meaning through their HTTP code, so a lifecycle conflict reads as
+// FailedPrecondition here and 409 over REST; a missing order and an open
+// circuit to product- or payment-service get codes of their own. Anything
+// else is logged and reported without its details.
+func toStatus(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return status.Error(codes.NotFound, "order not found")
+	}
+	if errors.Is(err, resilience.ErrUnavailable) {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	var fe *fiber.Error
+	if !errors.As(err, &fe) {
+		log.Printf("Unexpected order service error: %v", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+	switch fe.Code {
+	case fiber.StatusBadRequest:
+		return status.Error(codes.InvalidArgument, fe.Message)
+	case fiber.StatusNotFound:
+		return status.Error(codes.NotFound, fe.Message)
+	case fiber.StatusForbidden:
+		return status.Error(codes.PermissionDenied, fe.Message)
+	case fiber.StatusConflict:
+		return status.Error(codes.FailedPrecondition, fe.Message)
+	case fiber.StatusServiceUnavailable:
+		return status.Error(codes.Unavailable, fe.Message)
+	default:
+		return status.Error(codes.Internal, fe.Message)
+	}
+}
@@ -1,27 +1,44 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"log"
-	"os"
-	"os/signal"
-	"syscall"
+	"net"
+	"time"
 
+	"github.com/daffaromero/retries/services/common/discovery"
+	"github.com/daffaromero/retries/services/common/discovery/backend"
+	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	"github.com/daffaromero/retries/services/common/health"
+	"github.com/daffaromero/retries/services/common/migrate"
+	"github.com/daffaromero/retries/services/common/resilience"
+	"github.com/daffaromero/retries/services/common/shutdown"
+	"github.com/daffaromero/retries/services/common/store"
+	"github.com/daffaromero/retries/services/common/telemetry"
 	"github.com/daffaromero/retries/services/common/utils/logger"
 	"github.com/daffaromero/retries/services/order-service/config"
 	"github.com/daffaromero/retries/services/order-service/controller"
+	"github.com/daffaromero/retries/services/order-service/migrations"
 	"github.com/daffaromero/retries/services/order-service/repository"
 	"github.com/daffaromero/retries/services/order-service/repository/query"
 	"github.com/daffaromero/retries/services/order-service/service"
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/middleware/adaptor"
 	"github.com/gofiber/fiber/v3/middleware/cors"
 	flog "github.com/gofiber/fiber/v3/middleware/logger"
 	"github.com/gofiber/fiber/v3/middleware/requestid"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 var logs = logger.NewLog("main")
 
-func webServer() error {
+var migrateOnStart = flag.Bool("migrate", false, "apply pending schema migrations before serving")
+
+func webServer(ordCont controller.OrderController, checker *health.Checker, metrics *telemetry.Metrics) *fiber.App {
 	app := fiber.New(fiber.Config{
 		StreamRequestBody: true,
 	})
@@ -29,16 +46,6 @@ func webServer() error {
 	app.Use(requestid.New())
 	app.Use(flog.New())
 
-	serverConfig := config.NewServerConfig()
-	dbConfig := config.NewPGDatabase()
-	store := repository.NewStore(dbConfig)
-	validate := validator.New()
-
-	ordQuery := query.NewOrderQueryImpl(dbConfig)
-	ordRepo := repository.NewOrderRepository(store, ordQuery)
-	ordServ := service.NewOrderService(ordRepo, logs)
-	ordCont := controller.NewOrderController(validate, ordServ)
-
 	app.Use(cors.New(cors.Config{
 		AllowOriginsFunc: func(origin string) bool {
 			allowedOrigins := []string{
@@ -65,20 +72,112 @@ func webServer() error {
 	}))
 
 	ordCont.Route(app)
+	app.Get("/healthz", adaptor.HTTPHandlerFunc(checker.LiveHandler()))
+	app.Get("/readyz", adaptor.HTTPHandlerFunc(checker.ReadyHandler()))
+	app.Get("/metrics", adaptor.HTTPHandler(metrics.Handler()))
+	app.Get("/debug/breakers", adaptor.HTTPHandler(resilience.DebugHandler()))
 
-	err := app.Listen(serverConfig.Host)
-	if err != nil {
-		logs.Error(err)
-		return err
-	}
-	return nil
+	return app
+}
+
+func grpcServer(ordServ service.OrderService, checker *health.Checker) *grpc.Server {
+	srv := grpc.NewServer(grpc.StatsHandler(otelgrpc.NewServerHandler()))
+	pb.RegisterOrderServiceServer(srv, controller.NewOrderGRPCServer(ordServ))
+	healthpb.RegisterHealthServer(srv, checker.GRPC())
+
+	return srv
 }
 
 func main() {
-	if err := webServer(); err != nil {
-		log.Fatalf("webServer failed: %v", err)
+	flag.Parse()
+	ctx := context.Background()
+
+	serverConfig := config.NewServerConfig()
+	shutdownTimeout, err := shutdown.TimeoutFromEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	metrics, err := telemetry.NewMetrics("order-service")
+	if err != nil {
+		log.Fatal(err)
 	}
+	dbConfig := config.NewPGDatabase()
 
-	sigchan := make(chan os.Signal, 1)
-	signal.Notify(sigchan, syscall.SIGINT, syscall.SIGTERM)
+	migrator, err := migrate.New(dbConfig, "order-service", migrations.FS)
+	if err != nil {
+		log.Fatalf("failed to load migrations: %v", err)
+	}
+	if flag.Arg(0) == "migrate" {
+		if err := migrator.Run(ctx, flag.Args()[1:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if *migrateOnStart {
+		if err := migrator.Up(ctx); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := migrator.Check(ctx); err != nil {
+		log.Fatal(err)
+	}
+
+	dbStore := store.New(dbConfig, time.Duration(config.TimeOutDuration)*time.Second)
+	validate := validator.New()
+
+	registry, err := backend.NewFromEnv()
+	if err != nil {
+		log.Fatalf("failed to create service registry: %v", err)
+	}
+
+	ordQuery := query.NewOrderQueryImpl(dbConfig)
+	ordRepo := repository.NewOrderRepository(dbStore, ordQuery)
+	ordServ, err := service.NewOrderService(ctx, registry, ordRepo, config.NewPricingConfig(), logs)
+	if err != nil {
+		log.Fatalf("failed to create order service: %v", err)
+	}
+	ordCont := controller.NewOrderController(validate, ordServ)
+
+	reg, err := discovery.Register(ctx, registry, discovery.OrderServiceName, serverConfig.GRPCHost)
+	if err != nil {
+		log.Fatalf("failed to register order service: %v", err)
+	}
+
+	checker := health.NewChecker(discovery.OrderServiceName)
+	checker.Add("postgres", dbConfig.Ping)
+	checker.Add("downstream", discovery.CheckClients)
+	checker.Track(reg)
+	go checker.Run(ctx)
+
+	app := webServer(ordCont, checker, metrics)
+	srv := grpcServer(ordServ, checker)
+
+	lis, err := net.Listen("tcp", serverConfig.GRPCHost)
+	if err != nil {
+		log.Fatalf("failed to listen on %s: %v", serverConfig.GRPCHost, err)
+	}
+	go func() {
+		log.Printf("gRPC server listening on %s", serverConfig.GRPCHost)
+		if err := srv.Serve(lis); err != nil {
+			log.Fatalf("grpcServer failed: %v", err)
+		}
+	}()
+
+	go func() {
+		if err := app.Listen(serverConfig.Host); err != nil {
+			log.Fatalf("webServer failed: %v", err)
+		}
+	}()
+
+	stop := shutdown.New(shutdownTimeout)
+	stop.Add("mark not ready", checker.Shutdown)
+	stop.Add("deregister", func(context.Context) error { return reg.Close() })
+	stop.Add("drain servers", shutdown.All(app.ShutdownWithContext, shutdown.GRPCServer(srv)))
+	stop.Add("close database", func(context.Context) error {
+		dbConfig.Close()
+		return nil
+	})
+	stop.Add("stop metrics", metrics.Shutdown)
+	stop.Add("flush logs", func(context.Context) error { return logs.Sync() })
+	stop.Wait()
 }
@@ -0,0 +1 @@
+ALTER TABLE orders ALTER COLUMN id SET DATA TYPE INT USING id::INT;
@@ -0,0 +1,15 @@
+ALTER TABLE orders
+    DROP COLUMN updated_at,
+    DROP COLUMN created_at,
+    DROP COLUMN payment_link,
+    DROP COLUMN grand_total,
+    DROP COLUMN admin_fee,
+    DROP COLUMN total_payment,
+    DROP COLUMN settlement_status;
+-- The old columns held one numeric product and a quantity; what does not fit is dropped.
+ALTER TABLE orders ALTER COLUMN products_details SET DATA TYPE INT USING NULL;
+ALTER TABLE orders RENAME COLUMN products_details TO quantity;
+ALTER TABLE orders ALTER COLUMN product_ids SET DATA TYPE INT USING CASE WHEN product_ids[1] ~ '^[0-9]+$' THEN product_ids[1]::INT END;
+ALTER TABLE orders RENAME COLUMN product_ids TO product_id;
+ALTER TABLE orders ALTER COLUMN customer_id SET DATA TYPE INT USING CASE WHEN customer_id ~ '^[0-9]+$' THEN customer_id::INT END;
+ALTER TABLE orders ALTER COLUMN id SET DATA TYPE VARCHAR(36);
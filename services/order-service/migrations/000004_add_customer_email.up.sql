@@ -0,0 +1 @@
+ALTER TABLE orders ADD COLUMN customer_email VARCHAR;
@@ -0,0 +1,4 @@
+DROP INDEX order_history_order_id_idx;
+DROP TABLE order_history;
+ALTER TABLE orders ALTER COLUMN settlement_status DROP NOT NULL;
+ALTER TABLE orders ALTER COLUMN settlement_status DROP DEFAULT;
@@ -0,0 +1,20 @@
+UPDATE orders SET settlement_status = 'pending_payment' WHERE settlement_status = 'pending';
+UPDATE orders SET settlement_status = 'created'
+WHERE settlement_status IS NULL
+   OR settlement_status NOT IN ('created', 'pending_payment', 'paid', 'fulfilled', 'cancelled', 'expired', 'refunded');
+ALTER TABLE orders ALTER COLUMN settlement_status SET DEFAULT 'created';
+ALTER TABLE orders ALTER COLUMN settlement_status SET NOT NULL;
+
+CREATE TABLE order_history (
+    id VARCHAR PRIMARY KEY,
+    order_id VARCHAR NOT NULL REFERENCES orders (id),
+    from_status VARCHAR NOT NULL DEFAULT '',
+    to_status VARCHAR NOT NULL,
+    reason VARCHAR NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+CREATE INDEX order_history_order_id_idx ON order_history (order_id, created_at);
+
+INSERT INTO order_history (id, order_id, to_status, reason, created_at)
+SELECT gen_random_uuid()::VARCHAR, id, settlement_status, 'backfill', COALESCE(updated_at, created_at, NOW())
+FROM orders;
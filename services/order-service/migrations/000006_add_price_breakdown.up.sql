@@ -0,0 +1 @@
+ALTER TABLE orders ADD COLUMN price_breakdown JSONB;
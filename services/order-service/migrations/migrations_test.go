@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/daffaromero/retries/services/common/migrate"
+	"github.com/daffaromero/retries/services/common/migrate/migratetest"
+)
+
+func TestDownRevertsEveryMigration(t *testing.T) {
+	db := migratetest.DB(t, "order-service", FS)
+	ctx := context.Background()
+	if _, err := db.Exec(ctx, `INSERT INTO orders (id, customer_id, product_ids, products_details, settlement_status) VALUES ('1', '2', '{3}', '[]', 'created')`); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := migrate.New(db, "order-service", FS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Down(ctx, math.MaxInt); err != nil {
+		t.Fatalf("Down: %v", err)
+	}
+	if err := m.Up(ctx); err != nil {
+		t.Fatalf("Up after Down: %v", err)
+	}
+}
@@ -0,0 +1,30 @@
+package pricing
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type productCatalog struct {
+	client pb.ProductServiceClient
+}
+
+// NewCatalog reads products from product-service.
+func NewCatalog(client pb.ProductServiceClient) Catalog {
+	return &productCatalog{client: client}
+}
+
+func (p *productCatalog) GetProduct(ctx context.Context, id string) (*pb.Product, error) {
+	res, err := p.client.GetProductByID(ctx, &pb.GetProductFilter{Id: id})
+	if status.Code(err) == codes.NotFound || (err == nil && len(res.Products) == 0) {
+		return nil, fmt.Errorf("%w: %s", ErrProductNotFound, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res.Products[0], nil
+}
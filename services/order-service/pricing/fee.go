@@ -0,0 +1,50 @@
+package pricing
+
+// FeePolicy charges a fee on top of an order's subtotal.
+type FeePolicy interface {
+	Name() string
+	Fee(subtotal int32) int32
+}
+
+// PercentageFee charges Percent of the subtotal, rounded up, kept within Min
+// and Max. A zero Max means no cap.
+type PercentageFee struct {
+	Label   string
+	Percent float64
+	Min     int32
+	Max     int32
+}
+
+func (p PercentageFee) Name() string {
+	return p.Label
+}
+
+func (p PercentageFee) Fee(subtotal int32) int32 {
+	if p.Percent <= 0 {
+		return 0
+	}
+	// Integer hundredths of a percent keep the rounding exact.
+	bps := int64(p.Percent*100 + 0.5)
+	fee := int32((int64(subtotal)*bps + 9999) / 10000)
+	if fee < p.Min {
+		fee = p.Min
+	}
+	if p.Max > 0 && fee > p.Max {
+		fee = p.Max
+	}
+	return fee
+}
+
+// FlatFee charges the same Amount on every order.
+type FlatFee struct {
+	Label  string
+	Amount int32
+}
+
+func (f FlatFee) Name() string {
+	return f.Label
+}
+
+func (f FlatFee) Fee(int32) int32 {
+	return f.Amount
+}
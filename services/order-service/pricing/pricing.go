@@ -0,0 +1,149 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+)
+
+// Adjustment rules, in the order they are applied to a line.
+const (
+	RuleFlatPrice       = "flat_price"
+	RulePercentagePrice = "percentage_price"
+	RuleVoucher         = "voucher"
+)
+
+// voucherMinimumPrice is the list price a product must exceed before a voucher
+// can be applied to it.
+const voucherMinimumPrice = 5
+
+var (
+	ErrNoItems              = errors.New("order has no products")
+	ErrProductNotFound      = errors.New("product not found")
+	ErrVoucherNotApplicable = errors.New("voucher not applicable")
+	ErrBelowMinimumSubtotal = errors.New("order total is below the minimum")
+)
+
+// Catalog looks up the authoritative version of a product.
+type Catalog interface {
+	GetProduct(ctx context.Context, id string) (*pb.Product, error)
+}
+
+// Quote is a priced order: the breakdown to store with it and the product
+// lines as they should be charged.
+type Quote struct {
+	Breakdown *pb.PriceBreakdown
+	Items     []*pb.ProductDetails
+}
+
+// Engine prices orders. Clients only choose products and vouchers; every
+// price comes from the catalog.
+type Engine struct {
+	catalog         Catalog
+	minimumSubtotal int32
+	fees            []FeePolicy
+}
+
+func NewEngine(catalog Catalog, minimumSubtotal int32, fees ...FeePolicy) *Engine {
+	return &Engine{
+		catalog:         catalog,
+		minimumSubtotal: minimumSubtotal,
+		fees:            fees,
+	}
+}
+
+// Quote prices items. Only the product ID and voucher of each item are read.
+func (e *Engine) Quote(ctx context.Context, items []*pb.ProductDetails) (*Quote, error) {
+	if len(items) == 0 {
+		return nil, ErrNoItems
+	}
+
+	q := &Quote{Breakdown: &pb.PriceBreakdown{}}
+	for _, it := range items {
+		product, err := e.catalog.GetProduct(ctx, it.Id)
+		if err != nil {
+			return nil, err
+		}
+		line, err := priceLine(product, it.Voucher)
+		if err != nil {
+			return nil, err
+		}
+
+		q.Breakdown.Lines = append(q.Breakdown.Lines, line)
+		q.Breakdown.Subtotal += line.Price
+		item := &pb.ProductDetails{
+			Id:         product.Id,
+			Name:       product.Name,
+			Category:   product.CategoryName,
+			SellerName: product.SellerName,
+			Price:      line.Price,
+		}
+		if it.Voucher != "" {
+			item.Voucher = product.Voucher
+			item.VoucherDiscount = product.VoucherDiscount
+		}
+		q.Items = append(q.Items, item)
+	}
+
+	if q.Breakdown.Subtotal < e.minimumSubtotal {
+		return nil, fmt.Errorf("%w of %d", ErrBelowMinimumSubtotal, e.minimumSubtotal)
+	}
+
+	for _, policy := range e.fees {
+		amount := policy.Fee(q.Breakdown.Subtotal)
+		if amount <= 0 {
+			continue
+		}
+		q.Breakdown.Fees = append(q.Breakdown.Fees, &pb.Fee{Name: policy.Name(), Amount: amount})
+		q.Breakdown.TotalFees += amount
+	}
+	q.Breakdown.GrandTotal = q.Breakdown.Subtotal + q.Breakdown.TotalFees
+	return q, nil
+}
+
+// priceLine applies the product's pricing rules to its list price. A flat
+// price replaces the list price outright; otherwise a percentage price takes
+// its share off. A matching voucher is then taken off what remains.
+func priceLine(product *pb.Product, voucher string) (*pb.PriceLine, error) {
+	line := &pb.PriceLine{
+		ProductId: product.Id,
+		Name:      product.Name,
+		ListPrice: product.Price,
+		Price:     product.Price,
+	}
+
+	switch {
+	case product.FlatPrice > 0 && product.FlatPrice < line.Price:
+		adjust(line, RuleFlatPrice, line.Price-product.FlatPrice)
+	case product.PercentagePrice > 0:
+		adjust(line, RulePercentagePrice, percentOf(line.Price, product.PercentagePrice))
+	}
+
+	if voucher != "" {
+		if product.Voucher == "" || voucher != product.Voucher {
+			return nil, fmt.Errorf("%w to product %s", ErrVoucherNotApplicable, product.Id)
+		}
+		if product.Price <= voucherMinimumPrice {
+			return nil, fmt.Errorf("%w: products must cost more than %d", ErrVoucherNotApplicable, voucherMinimumPrice)
+		}
+		adjust(line, RuleVoucher, percentOf(line.Price, product.VoucherDiscount))
+	}
+	return line, nil
+}
+
+func adjust(line *pb.PriceLine, rule string, amount int32) {
+	if amount <= 0 {
+		return
+	}
+	line.Adjustments = append(line.Adjustments, &pb.PriceAdjustment{Rule: rule, Amount: amount})
+	line.Price -= amount
+}
+
+// percentOf returns pct percent of amount, rounded half up. pct is clamped to
+// [0, 100] so a bad catalog entry can not push a price below zero.
+func percentOf(amount, pct int32) int32 {
+	pct = min(max(pct, 0), 100)
+	return (amount*pct + 50) / 100
+}
@@ -0,0 +1,228 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+)
+
+type fakeCatalog map[string]*pb.Product
+
+func (f fakeCatalog) GetProduct(ctx context.Context, id string) (*pb.Product, error) {
+	p, ok := f[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrProductNotFound, id)
+	}
+	return p, nil
+}
+
+var catalog = fakeCatalog{
+	"plain":   {Id: "plain", Name: "Plain", Price: 100},
+	"flat":    {Id: "flat", Name: "Flat", Price: 100, FlatPrice: 80},
+	"percent": {Id: "percent", Name: "Percent", Price: 100, PercentagePrice: 25},
+	"both":    {Id: "both", Name: "Both", Price: 100, FlatPrice: 70, PercentagePrice: 50},
+	"voucher": {Id: "voucher", Name: "Voucher", Price: 100, Voucher: "SAVE10", VoucherDiscount: 10},
+	"stacked": {Id: "stacked", Name: "Stacked", Price: 200, FlatPrice: 150, Voucher: "SAVE10", VoucherDiscount: 10},
+	"cheap":   {Id: "cheap", Name: "Cheap", Price: 5, Voucher: "SAVE10", VoucherDiscount: 10},
+	"odd":     {Id: "odd", Name: "Odd", Price: 33, PercentagePrice: 15},
+	"tiny":    {Id: "tiny", Name: "Tiny", Price: 3},
+}
+
+func TestQuote(t *testing.T) {
+	adminFee := PercentageFee{Label: "Admin fee", Percent: 2}
+
+	tests := []struct {
+		name       string
+		items      []*pb.ProductDetails
+		fees       []FeePolicy
+		wantPrices []int32
+		wantFees   []int32
+		wantTotal  int32
+		wantErr    error
+	}{
+		{
+			name:       "list price",
+			items:      []*pb.ProductDetails{{Id: "plain"}},
+			wantPrices: []int32{100},
+			wantTotal:  100,
+		},
+		{
+			name:       "client price is ignored",
+			items:      []*pb.ProductDetails{{Id: "plain", Price: 1}},
+			wantPrices: []int32{100},
+			wantTotal:  100,
+		},
+		{
+			name:       "flat price",
+			items:      []*pb.ProductDetails{{Id: "flat"}},
+			wantPrices: []int32{80},
+			wantTotal:  80,
+		},
+		{
+			name:       "percentage price",
+			items:      []*pb.ProductDetails{{Id: "percent"}},
+			wantPrices: []int32{75},
+			wantTotal:  75,
+		},
+		{
+			name:       "flat price wins over percentage price",
+			items:      []*pb.ProductDetails{{Id: "both"}},
+			wantPrices: []int32{70},
+			wantTotal:  70,
+		},
+		{
+			name:       "percentage rounds half up",
+			items:      []*pb.ProductDetails{{Id: "odd"}},
+			wantPrices: []int32{28},
+			wantTotal:  28,
+		},
+		{
+			name:       "voucher",
+			items:      []*pb.ProductDetails{{Id: "voucher", Voucher: "SAVE10", VoucherDiscount: 90}},
+			wantPrices: []int32{90},
+			wantTotal:  90,
+		},
+		{
+			name:       "voucher applies after flat price",
+			items:      []*pb.ProductDetails{{Id: "stacked", Voucher: "SAVE10"}},
+			wantPrices: []int32{135},
+			wantTotal:  135,
+		},
+		{
+			name:    "wrong voucher",
+			items:   []*pb.ProductDetails{{Id: "voucher", Voucher: "SAVE99"}},
+			wantErr: ErrVoucherNotApplicable,
+		},
+		{
+			name:    "voucher on product without one",
+			items:   []*pb.ProductDetails{{Id: "plain", Voucher: "SAVE10"}},
+			wantErr: ErrVoucherNotApplicable,
+		},
+		{
+			name:    "voucher on cheap product",
+			items:   []*pb.ProductDetails{{Id: "cheap", Voucher: "SAVE10"}},
+			wantErr: ErrVoucherNotApplicable,
+		},
+		{
+			name:    "unknown product",
+			items:   []*pb.ProductDetails{{Id: "missing"}},
+			wantErr: ErrProductNotFound,
+		},
+		{
+			name:    "no items",
+			wantErr: ErrNoItems,
+		},
+		{
+			name:    "below minimum",
+			items:   []*pb.ProductDetails{{Id: "tiny"}},
+			wantErr: ErrBelowMinimumSubtotal,
+		},
+		{
+			name:       "percentage fee rounds up",
+			items:      []*pb.ProductDetails{{Id: "plain"}, {Id: "odd"}},
+			fees:       []FeePolicy{adminFee},
+			wantPrices: []int32{100, 28},
+			wantFees:   []int32{3},
+			wantTotal:  131,
+		},
+		{
+			name:       "percentage fee is charged below five",
+			items:      []*pb.ProductDetails{{Id: "plain"}},
+			fees:       []FeePolicy{adminFee},
+			wantPrices: []int32{100},
+			wantFees:   []int32{2},
+			wantTotal:  102,
+		},
+		{
+			name:       "percentage fee minimum",
+			items:      []*pb.ProductDetails{{Id: "plain"}},
+			fees:       []FeePolicy{PercentageFee{Label: "Admin fee", Percent: 2, Min: 5}},
+			wantPrices: []int32{100},
+			wantFees:   []int32{5},
+			wantTotal:  105,
+		},
+		{
+			name:       "percentage fee cap",
+			items:      []*pb.ProductDetails{{Id: "plain"}},
+			fees:       []FeePolicy{PercentageFee{Label: "Admin fee", Percent: 10, Max: 4}},
+			wantPrices: []int32{100},
+			wantFees:   []int32{4},
+			wantTotal:  104,
+		},
+		{
+			name:       "flat and percentage fees",
+			items:      []*pb.ProductDetails{{Id: "plain"}},
+			fees:       []FeePolicy{adminFee, FlatFee{Label: "Service fee", Amount: 1}},
+			wantPrices: []int32{100},
+			wantFees:   []int32{2, 1},
+			wantTotal:  103,
+		},
+		{
+			name:       "zero fees are left out",
+			items:      []*pb.ProductDetails{{Id: "plain"}},
+			fees:       []FeePolicy{PercentageFee{Label: "Admin fee"}, FlatFee{Label: "Service fee"}},
+			wantPrices: []int32{100},
+			wantTotal:  100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := NewEngine(catalog, 5, tt.fees...).Quote(context.Background(), tt.items)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Quote() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Quote() error = %v", err)
+			}
+
+			b := q.Breakdown
+			if len(b.Lines) != len(tt.wantPrices) || len(q.Items) != len(tt.wantPrices) {
+				t.Fatalf("got %d lines and %d items, want %d", len(b.Lines), len(q.Items), len(tt.wantPrices))
+			}
+			var subtotal int32
+			for i, want := range tt.wantPrices {
+				if b.Lines[i].Price != want {
+					t.Errorf("line %d price = %d, want %d", i, b.Lines[i].Price, want)
+				}
+				if q.Items[i].Price != want {
+					t.Errorf("item %d price = %d, want %d", i, q.Items[i].Price, want)
+				}
+				var adjusted int32
+				for _, adj := range b.Lines[i].Adjustments {
+					adjusted += adj.Amount
+				}
+				if b.Lines[i].ListPrice-adjusted != want {
+					t.Errorf("line %d adjustments do not add up: list %d, adjusted %d", i, b.Lines[i].ListPrice, adjusted)
+				}
+				subtotal += want
+			}
+			if b.Subtotal != subtotal {
+				t.Errorf("subtotal = %d, want %d", b.Subtotal, subtotal)
+			}
+
+			if len(b.Fees) != len(tt.wantFees) {
+				t.Fatalf("got %d fees, want %d", len(b.Fees), len(tt.wantFees))
+			}
+			var fees int32
+			for i, want := range tt.wantFees {
+				if b.Fees[i].Amount != want {
+					t.Errorf("fee %d = %d, want %d", i, b.Fees[i].Amount, want)
+				}
+				fees += want
+			}
+			if b.TotalFees != fees {
+				t.Errorf("total fees = %d, want %d", b.TotalFees, fees)
+			}
+			if b.GrandTotal != tt.wantTotal {
+				t.Errorf("grand total = %d, want %d", b.GrandTotal, tt.wantTotal)
+			}
+		})
+	}
+}
@@ -5,46 +5,57 @@ import (
 	"fmt"
 
 	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	"github.com/daffaromero/retries/services/common/store"
 	"github.com/daffaromero/retries/services/order-service/repository/query"
-	"github.com/daffaromero/retries/services/payment-service/processor"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
-	"github.com/stripe/stripe-go/v79"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type OrderRepository interface {
 	CreateOrder(context.Context, *pb.Order) (*pb.Order, error)
 	GetOrderDetails(context.Context, *pb.GetOrderFilter) (*pb.GetOrderResponse, error)
 	GetAllOrders(context.Context, *pb.GetOrdersRequest) (*pb.GetOrderResponse, error)
-	SendOrder(context.Context, *pb.SendOrderRequest) (*stripe.PaymentLink, error)
+	SendOrder(c context.Context, t Transition, paymentLink string) error
+	UpdateStatus(context.Context, Transition) (*pb.Order, error)
+	GetOrderHistory(c context.Context, orderID string) ([]*pb.OrderHistoryEntry, error)
+	ModifyOrder(c context.Context, orderID, reason string, apply func(*pb.Order) error) (*pb.Order, error)
 }
 
+// Transition moves an order to another settlement status. Check decides
+// whether the move is legal; it runs against the status read under the row
+// lock, so the decision and the write happen in the same transaction.
+type Transition struct {
+	OrderID string
+	To      string
+	Reason  string
+	Check   func(from, to string) error
+}
+
+// lockAttempts is how many times a transaction that locks an order is run
+// while it fails with a deadlock or serialization failure. Their functions
+// only touch the database, so running them again is safe.
+const lockAttempts = 3
+
 type orderRepository struct {
-	db        Store
-	ordQuery  query.OrderQuery
-	processor processor.Stripe
+	db       store.Store
+	ordQuery query.OrderQuery
 }
 
-func NewOrderRepository(db Store, ordQuery query.OrderQuery) OrderRepository {
+func NewOrderRepository(db store.Store, ordQuery query.OrderQuery) OrderRepository {
 	return &orderRepository{db: db, ordQuery: ordQuery}
 }
 
 func (o *orderRepository) CreateOrder(c context.Context, ord *pb.Order) (*pb.Order, error) {
 	var res *pb.Order
 	if err := o.db.WithTx(c, func(tx pgx.Tx) error {
-		if _, err := o.ordQuery.GetOrderDetails(c, &pb.GetOrderFilter{CustomerId: ord.CustomerId}); err == nil {
-			if err == pgx.ErrNoRows {
-				re, err := o.ordQuery.CreateOrder(c, tx, ord)
-				if err != nil {
-					return err
-				}
-				res = re
-			}
-			if len(ord.ProductIds) == 0 {
-				return err
-			}
+		re, err := o.ordQuery.CreateOrder(c, tx, ord)
+		if err != nil {
+			return err
 		}
-		return nil
+		res = re
+		return o.ordQuery.InsertHistory(c, tx, historyEntry(ord.Id, "", ord.SettlementStatus, "order created"))
 	}); err != nil {
 		return nil, err
 	}
@@ -69,35 +80,120 @@ func (o *orderRepository) GetOrderDetails(c context.Context, fil *pb.GetOrderFil
 
 func (o *orderRepository) GetAllOrders(c context.Context, req *pb.GetOrdersRequest) (*pb.GetOrderResponse, error) {
 	var res *pb.GetOrderResponse
-	err := o.db.WithoutTx(c, func(pool *pgxpool.Pool) error {
-		ords, err := o.ordQuery.GetOrders(c, req)
+	// The page and its total are read from one snapshot.
+	err := o.db.WithTx(c, func(tx pgx.Tx) error {
+		ords, err := o.ordQuery.GetOrders(c, tx, req)
 		if err != nil {
 			return err
 		}
 		res = ords
 		return nil
-	})
+	}, store.Isolation(pgx.RepeatableRead), store.ReadOnly())
 	if err != nil {
 		return nil, err
 	}
 	return res, nil
 }
 
-func (o *orderRepository) SendOrder(c context.Context, req *pb.SendOrderRequest) (*stripe.PaymentLink, error) {
-	pl, err := o.processor.CreatePaymentLink(req)
+func (o *orderRepository) SendOrder(c context.Context, t Transition, paymentLink string) error {
+	err := o.db.WithTx(c, func(tx pgx.Tx) error {
+		if err := o.transition(c, tx, t); err != nil {
+			return err
+		}
+		return o.ordQuery.SendOrder(c, tx, &pb.SendOrderRequest{OrderId: t.OrderID}, t.To, paymentLink)
+	}, store.MaxAttempts(lockAttempts))
 	if err != nil {
+		return fmt.Errorf("failed to send order: %w", err)
+	}
+	return nil
+}
+
+func (o *orderRepository) UpdateStatus(c context.Context, t Transition) (*pb.Order, error) {
+	var res *pb.Order
+	if err := o.db.WithTx(c, func(tx pgx.Tx) error {
+		if err := o.transition(c, tx, t); err != nil {
+			return err
+		}
+		ord, err := o.ordQuery.UpdateSettlementStatus(c, tx, t.OrderID, t.To)
+		if err != nil {
+			return err
+		}
+		res = ord
+		return nil
+	}, store.MaxAttempts(lockAttempts)); err != nil {
 		return nil, err
 	}
-	err = o.db.WithTx(c, func(tx pgx.Tx) error {
-		err := o.ordQuery.SendOrder(c, tx, req, "pending", pl)
+	return res, nil
+}
+
+func (o *orderRepository) GetOrderHistory(c context.Context, orderID string) ([]*pb.OrderHistoryEntry, error) {
+	var res []*pb.OrderHistoryEntry
+	err := o.db.WithoutTx(c, func(pool *pgxpool.Pool) error {
+		history, err := o.ordQuery.GetOrderHistory(c, orderID)
 		if err != nil {
 			return err
 		}
+		res = history
 		return nil
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to send order: %w", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// ModifyOrder locks the order and hands it to apply, which checks and changes
+// it in place. The changed order is written back in the same transaction,
+// along with a history entry when its status moved; an error from apply rolls
+// everything back.
+func (o *orderRepository) ModifyOrder(c context.Context, orderID, reason string, apply func(*pb.Order) error) (*pb.Order, error) {
+	var res *pb.Order
+	if err := o.db.WithTx(c, func(tx pgx.Tx) error {
+		ord, err := o.ordQuery.LockOrder(c, tx, orderID)
+		if err != nil {
+			return err
+		}
+		from := ord.SettlementStatus
+		if err := apply(ord); err != nil {
+			return err
+		}
+		if ord.SettlementStatus != from {
+			if err := o.ordQuery.InsertHistory(c, tx, historyEntry(orderID, from, ord.SettlementStatus, reason)); err != nil {
+				return err
+			}
+		}
+		updated, err := o.ordQuery.UpdateOrder(c, tx, ord)
+		if err != nil {
+			return err
+		}
+		res = updated
+		return nil
+	}, store.MaxAttempts(lockAttempts)); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// transition locks the order, checks the move and records it in the order's
+// history. The caller writes the new status in the same transaction.
+func (o *orderRepository) transition(c context.Context, tx pgx.Tx, t Transition) error {
+	ord, err := o.ordQuery.LockOrder(c, tx, t.OrderID)
+	if err != nil {
+		return err
 	}
+	if err := t.Check(ord.SettlementStatus, t.To); err != nil {
+		return err
+	}
+	return o.ordQuery.InsertHistory(c, tx, historyEntry(t.OrderID, ord.SettlementStatus, t.To, t.Reason))
+}
 
-	return &stripe.PaymentLink{URL: pl}, nil
+func historyEntry(orderID, from, to, reason string) *pb.OrderHistoryEntry {
+	return &pb.OrderHistoryEntry{
+		Id:         uuid.New().String(),
+		OrderId:    orderID,
+		FromStatus: from,
+		ToStatus:   to,
+		Reason:     reason,
+		CreatedAt:  timestamppb.Now(),
+	}
 }
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	"github.com/daffaromero/retries/services/common/migrate/migratetest"
+	"github.com/daffaromero/retries/services/common/store"
+	"github.com/daffaromero/retries/services/order-service/migrations"
+	"github.com/daffaromero/retries/services/order-service/repository/query"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// fakeStore runs fn without a database; the fake query ignores the tx.
+type fakeStore struct{}
+
+func (fakeStore) WithTx(ctx context.Context, fn func(pgx.Tx) error, opts ...store.TxOption) error {
+	return fn(nil)
+}
+
+func (fakeStore) WithoutTx(ctx context.Context, fn func(*pgxpool.Pool) error) error {
+	return fn(nil)
+}
+
+// fakeQuery holds a single order and the history written for it.
+type fakeQuery struct {
+	query.OrderQuery
+	order   *pb.Order
+	history []*pb.OrderHistoryEntry
+}
+
+func (f *fakeQuery) LockOrder(c context.Context, tx pgx.Tx, id string) (*pb.Order, error) {
+	if f.order.Id != id {
+		return nil, pgx.ErrNoRows
+	}
+	return proto.Clone(f.order).(*pb.Order), nil
+}
+
+func (f *fakeQuery) InsertHistory(c context.Context, tx pgx.Tx, entry *pb.OrderHistoryEntry) error {
+	f.history = append(f.history, entry)
+	return nil
+}
+
+func (f *fakeQuery) UpdateOrder(c context.Context, tx pgx.Tx, order *pb.Order) (*pb.Order, error) {
+	f.order = order
+	return order, nil
+}
+
+func TestModifyOrderHistory(t *testing.T) {
+	tests := []struct {
+		name        string
+		apply       func(*pb.Order) error
+		wantHistory int
+	}{
+		{
+			name:        "status change",
+			apply:       func(ord *pb.Order) error { ord.SettlementStatus = "fulfilled"; return nil },
+			wantHistory: 1,
+		},
+		{
+			name:        "same status",
+			apply:       func(ord *pb.Order) error { ord.CustomerEmail = "new@example.com"; return nil },
+			wantHistory: 0,
+		},
+		{
+			name:        "rejected",
+			apply:       func(ord *pb.Order) error { return errors.New("rejected") },
+			wantHistory: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := &fakeQuery{order: &pb.Order{Id: "order-1", SettlementStatus: "paid"}}
+			repo := NewOrderRepository(fakeStore{}, q)
+
+			_, _ = repo.ModifyOrder(context.Background(), "order-1", "test", tt.apply)
+			if len(q.history) != tt.wantHistory {
+				t.Fatalf("history entries = %d, want %d", len(q.history), tt.wantHistory)
+			}
+			if tt.wantHistory == 1 {
+				if e := q.history[0]; e.FromStatus != "paid" || e.ToStatus != "fulfilled" || e.Reason != "test" {
+					t.Errorf("history entry = %s -> %s (%s)", e.FromStatus, e.ToStatus, e.Reason)
+				}
+			}
+		})
+	}
+}
+
+var errIllegal = errors.New("illegal transition")
+
+// allow accepts any move but one out of a final status.
+func allow(from, to string) error {
+	if from == "cancelled" {
+		return errIllegal
+	}
+	return nil
+}
+
+func TestLifecycleOnSchema(t *testing.T) {
+	db := migratetest.DB(t, "order-service", migrations.FS)
+	repo := NewOrderRepository(store.New(db, time.Second), query.NewOrderQueryImpl(db))
+	ctx := context.Background()
+
+	now := timestamppb.Now()
+	if _, err := repo.CreateOrder(ctx, &pb.Order{
+		Id:               "order-1",
+		CustomerId:       "customer-1",
+		ProductIds:       []string{"a"},
+		ProductsDetails:  []*pb.ProductDetails{{Id: "a", Price: 100}},
+		SettlementStatus: "created",
+		TotalPayment:     100,
+		GrandTotal:       100,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := repo.SendOrder(ctx, Transition{OrderID: "order-1", To: "pending_payment", Reason: "sent", Check: allow}, "https://pay.example.com/1"); err != nil {
+		t.Fatalf("SendOrder: %v", err)
+	}
+	ord, err := repo.UpdateStatus(ctx, Transition{OrderID: "order-1", To: "paid", Reason: "webhook", Check: allow})
+	if err != nil {
+		t.Fatalf("UpdateStatus: %v", err)
+	}
+	if ord.SettlementStatus != "paid" || ord.PaymentLink != "https://pay.example.com/1" {
+		t.Errorf("after UpdateStatus: %s with link %q", ord.SettlementStatus, ord.PaymentLink)
+	}
+
+	ord, err = repo.ModifyOrder(ctx, "order-1", "cancelled by customer", func(ord *pb.Order) error {
+		ord.SettlementStatus = "cancelled"
+		ord.ProductsDetails[0].Refunded = true
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ModifyOrder: %v", err)
+	}
+	if ord.SettlementStatus != "cancelled" || !ord.ProductsDetails[0].Refunded || !ord.UpdatedAt.AsTime().After(ord.CreatedAt.AsTime()) {
+		t.Errorf("after ModifyOrder: %v", ord)
+	}
+
+	// A rejected move writes nothing.
+	if _, err := repo.UpdateStatus(ctx, Transition{OrderID: "order-1", To: "paid", Check: allow}); !errors.Is(err, errIllegal) {
+		t.Errorf("UpdateStatus out of cancelled = %v, want errIllegal", err)
+	}
+	if _, err := repo.UpdateStatus(ctx, Transition{OrderID: "order-missing", To: "paid", Check: allow}); !errors.Is(err, pgx.ErrNoRows) {
+		t.Errorf("UpdateStatus(missing) = %v, want pgx.ErrNoRows", err)
+	}
+
+	history, err := repo.GetOrderHistory(ctx, "order-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var moves []string
+	for _, e := range history {
+		moves = append(moves, e.FromStatus+">"+e.ToStatus)
+	}
+	if want := []string{">created", "created>pending_payment", "pending_payment>paid", "paid>cancelled"}; !slices.Equal(moves, want) {
+		t.Errorf("history = %v, want %v", moves, want)
+	}
+}
@@ -7,16 +7,37 @@ import (
 	"time"
 
 	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	"github.com/daffaromero/retries/services/common/sqlbuilder"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type OrderQuery interface {
 	CreateOrder(c context.Context, tx pgx.Tx, order *pb.Order) (*pb.Order, error)
 	GetOrderDetails(c context.Context, req *pb.GetOrderFilter) (*pb.GetOrderResponse, error)
-	GetOrders(c context.Context, req *pb.GetOrdersRequest) (*pb.GetOrderResponse, error)
+	GetOrders(c context.Context, tx pgx.Tx, req *pb.GetOrdersRequest) (*pb.GetOrderResponse, error)
 	UpdateOrder(c context.Context, tx pgx.Tx, order *pb.Order) (*pb.Order, error)
 	SendOrder(c context.Context, tx pgx.Tx, req *pb.SendOrderRequest, status, paymentLink string) error
+	UpdateSettlementStatus(c context.Context, tx pgx.Tx, id, status string) (*pb.Order, error)
+	LockOrder(c context.Context, tx pgx.Tx, id string) (*pb.Order, error)
+	InsertHistory(c context.Context, tx pgx.Tx, entry *pb.OrderHistoryEntry) error
+	GetOrderHistory(c context.Context, orderID string) ([]*pb.OrderHistoryEntry, error)
+}
+
+const orderColumns = `id, customer_id, product_ids, products_details, settlement_status, total_payment, COALESCE(admin_fee, 0), COALESCE(grand_total, 0), COALESCE(payment_link, ''), COALESCE(customer_email, ''), price_breakdown, created_at, updated_at`
+
+// scanOrder reads a row selected with orderColumns. pgx can not scan a
+// timestamp into a protobuf one, so those go through time.Time. The columns
+// have no time zone and hold UTC, which is what timestamppb converts to.
+func scanOrder(row pgx.Row, order *pb.Order) error {
+	var createdAt, updatedAt time.Time
+	if err := row.Scan(&order.Id, &order.CustomerId, &order.ProductIds, &order.ProductsDetails, &order.SettlementStatus, &order.TotalPayment, &order.AdminFee, &order.GrandTotal, &order.PaymentLink, &order.CustomerEmail, &order.PriceBreakdown, &createdAt, &updatedAt); err != nil {
+		return err
+	}
+	order.CreatedAt = timestamppb.New(createdAt)
+	order.UpdatedAt = timestamppb.New(updatedAt)
+	return nil
 }
 
 type OrderQueryImpl struct {
@@ -28,8 +49,8 @@ func NewOrderQueryImpl(db *pgxpool.Pool) OrderQuery {
 }
 
 func (o *OrderQueryImpl) CreateOrder(c context.Context, tx pgx.Tx, req *pb.Order) (*pb.Order, error) {
-	query := `INSERT INTO orders (id, customer_id, product_ids, products_details, settlement_status, total_payment, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
-	_, err := tx.Exec(c, query, req.Id, req.CustomerId, req.ProductIds, req.ProductsDetails, req.SettlementStatus, req.TotalPayment, req.CreatedAt, req.UpdatedAt)
+	query := `INSERT INTO orders (id, customer_id, product_ids, products_details, settlement_status, total_payment, admin_fee, grand_total, customer_email, price_breakdown, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+	_, err := tx.Exec(c, query, req.Id, req.CustomerId, req.ProductIds, req.ProductsDetails, req.SettlementStatus, req.TotalPayment, req.AdminFee, req.GrandTotal, req.CustomerEmail, req.PriceBreakdown, req.CreatedAt.AsTime(), req.UpdatedAt.AsTime())
 	if err != nil {
 		log.Println(err)
 		return nil, err
@@ -41,58 +62,85 @@ func (o *OrderQueryImpl) CreateOrder(c context.Context, tx pgx.Tx, req *pb.Order
 		ProductsDetails:  req.ProductsDetails,
 		SettlementStatus: req.SettlementStatus,
 		TotalPayment:     req.TotalPayment,
+		AdminFee:         req.AdminFee,
+		GrandTotal:       req.GrandTotal,
+		CustomerEmail:    req.CustomerEmail,
+		PriceBreakdown:   req.PriceBreakdown,
 		CreatedAt:        req.CreatedAt,
 		UpdatedAt:        req.UpdatedAt,
 	}, nil
 }
 
 func (o *OrderQueryImpl) GetOrderDetails(c context.Context, fil *pb.GetOrderFilter) (*pb.GetOrderResponse, error) {
-	query := `SELECT id, customer_id, product_ids, products_details, settlement_status, total_payment, created_at, updated_at from orders WHERE id=$1`
-	rows, err := o.db.Query(c, query, fil.OrderId)
+	query := `SELECT ` + orderColumns + ` FROM orders WHERE id = $1`
+	arg := fil.OrderId
+	if fil.OrderId == "" {
+		query = `SELECT ` + orderColumns + ` FROM orders WHERE customer_id = $1 ORDER BY created_at DESC`
+		arg = fil.CustomerId
+	}
+	rows, err := o.db.Query(c, query, arg)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, fmt.Errorf("no orders found for with ID %s", fil.OrderId)
-		}
 		log.Printf("Error querying orders: %v", err)
 		return nil, fmt.Errorf("failed to retrieve orders: %w", err)
 	}
+	defer rows.Close()
+
 	var orders []*pb.Order
-	var order pb.Order
-	err = rows.Scan(&order.Id, &order.CustomerId, &order.ProductIds, &order.ProductsDetails, &order.SettlementStatus, &order.TotalPayment, &order.CreatedAt, &order.UpdatedAt)
-	if err != nil {
-		return nil, err
+	for rows.Next() {
+		var order pb.Order
+		if err := scanOrder(rows, &order); err != nil {
+			return nil, fmt.Errorf("scan error: %w", err)
+		}
+		orders = append(orders, &order)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	if len(orders) == 0 {
+		return nil, pgx.ErrNoRows
 	}
-	orders = append(orders, &order)
 	return &pb.GetOrderResponse{Orders: orders}, nil
 }
-func (o *OrderQueryImpl) GetOrders(c context.Context, req *pb.GetOrdersRequest) (*pb.GetOrderResponse, error) {
-	query := `SELECT id, customer_id, product_ids, products_details, settlement_status, total_payment, created_at, updated_at FROM orders LIMIT $1 OFFSET $2`
-	rows, err := o.db.Query(c, query, req.Pagination.GetLimit(), req.Pagination.GetOffset())
+
+func (o *OrderQueryImpl) GetOrders(c context.Context, tx pgx.Tx, req *pb.GetOrdersRequest) (*pb.GetOrderResponse, error) {
+	q, err := OrderFilters(req)
 	if err != nil {
-		return nil, fmt.Errorf("query error: %v", err)
+		return nil, err
+	}
+	query, args := q.Build()
+	rows, err := tx.Query(c, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
 	}
 	defer rows.Close()
 
 	var orders []*pb.Order
 	for rows.Next() {
 		var order pb.Order
-		if err := rows.Scan(&order.Id, &order.CustomerId, &order.ProductIds, &order.ProductsDetails, &order.SettlementStatus, &order.TotalPayment, &order.CreatedAt, &order.UpdatedAt); err != nil {
-			return nil, fmt.Errorf("scan error: %v", err)
+		if err := scanOrder(rows, &order); err != nil {
+			return nil, fmt.Errorf("scan error: %w", err)
 		}
 		orders = append(orders, &order)
 	}
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("rows error: %v", err)
+		return nil, fmt.Errorf("rows error: %w", err)
 	}
-	return &pb.GetOrderResponse{Orders: orders}, nil
+
+	res := &pb.GetOrderResponse{}
+	res.Orders, res.NextCursor = sqlbuilder.Next(q, orders, orderKey)
+	if req.Pagination.GetWithTotal() {
+		query, args := q.Count()
+		if err := tx.QueryRow(c, query, args...).Scan(&res.Total); err != nil {
+			return nil, fmt.Errorf("count error: %w", err)
+		}
+	}
+	return res, nil
 }
 
 func (o *OrderQueryImpl) UpdateOrder(c context.Context, tx pgx.Tx, order *pb.Order) (*pb.Order, error) {
-	query := `UPDATE orders SET customer_id = $1, product_ids = $2, products_details = $3, settlement_status = $4, total_payment = $5, updated_at = $6 WHERE id = $7 RETURNING id, customer_id, product_ids, products_details, settlement_status, total_payment, created_at, updated_at`
+	query := `UPDATE orders SET customer_id = $1, product_ids = $2, products_details = $3, settlement_status = $4, total_payment = $5, admin_fee = $6, grand_total = $7, price_breakdown = $8, updated_at = $9 WHERE id = $10 RETURNING ` + orderColumns
 	var updatedOrder pb.Order
-	err := tx.QueryRow(c, query, order.CustomerId, order.ProductIds, order.ProductsDetails, order.SettlementStatus, order.TotalPayment, order.UpdatedAt, order.Id).Scan(
-		&updatedOrder.Id, &updatedOrder.CustomerId, &updatedOrder.ProductIds, &updatedOrder.ProductsDetails, &updatedOrder.SettlementStatus, &updatedOrder.TotalPayment, &updatedOrder.CreatedAt, &updatedOrder.UpdatedAt,
-	)
+	err := scanOrder(tx.QueryRow(c, query, order.CustomerId, order.ProductIds, order.ProductsDetails, order.SettlementStatus, order.TotalPayment, order.AdminFee, order.GrandTotal, order.PriceBreakdown, time.Now().UTC(), order.Id), &updatedOrder)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update order: %w", err)
 	}
@@ -100,10 +148,67 @@ func (o *OrderQueryImpl) UpdateOrder(c context.Context, tx pgx.Tx, order *pb.Ord
 }
 
 func (o *OrderQueryImpl) SendOrder(c context.Context, tx pgx.Tx, req *pb.SendOrderRequest, status, paymentLink string) error {
-	query := `UPDATE orders SET settlement_status = $1, payment_link = $2, updated_at = $3 WHERE id = $3`
-	_, err := tx.Exec(c, query, status, time.Now(), req.OrderId)
+	query := `UPDATE orders SET settlement_status = $1, payment_link = $2, updated_at = $3 WHERE id = $4`
+	_, err := tx.Exec(c, query, status, paymentLink, time.Now().UTC(), req.OrderId)
 	if err != nil {
-		return fmt.Errorf("failed to send order: %v", err)
+		return fmt.Errorf("failed to send order: %w", err)
 	}
 	return nil
 }
+
+func (o *OrderQueryImpl) UpdateSettlementStatus(c context.Context, tx pgx.Tx, id, status string) (*pb.Order, error) {
+	query := `UPDATE orders SET settlement_status = $1, updated_at = $2 WHERE id = $3 RETURNING ` + orderColumns
+	var order pb.Order
+	err := scanOrder(tx.QueryRow(c, query, status, time.Now().UTC(), id), &order)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update settlement status: %w", err)
+	}
+	return &order, nil
+}
+
+// LockOrder reads an order and holds its row lock until tx ends, so that
+// status checks and the writes that follow them cannot interleave.
+func (o *OrderQueryImpl) LockOrder(c context.Context, tx pgx.Tx, id string) (*pb.Order, error) {
+	query := `SELECT ` + orderColumns + ` FROM orders WHERE id = $1 FOR UPDATE`
+	var order pb.Order
+	if err := scanOrder(tx.QueryRow(c, query, id), &order); err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+func (o *OrderQueryImpl) InsertHistory(c context.Context, tx pgx.Tx, entry *pb.OrderHistoryEntry) error {
+	query := `INSERT INTO order_history (id, order_id, from_status, to_status, reason, created_at) VALUES ($1, $2, $3, $4, $5, $6)`
+	_, err := tx.Exec(c, query, entry.Id, entry.OrderId, entry.FromStatus, entry.ToStatus, entry.Reason, entry.CreatedAt.AsTime())
+	if err != nil {
+		return fmt.Errorf("failed to insert order history: %w", err)
+	}
+	return nil
+}
+
+func (o *OrderQueryImpl) GetOrderHistory(c context.Context, orderID string) ([]*pb.OrderHistoryEntry, error) {
+	query := `SELECT id, order_id, from_status, to_status, reason, created_at FROM order_history WHERE order_id = $1 ORDER BY created_at, id`
+	rows, err := o.db.Query(c, query, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+
+	var history []*pb.OrderHistoryEntry
+	for rows.Next() {
+		var entry pb.OrderHistoryEntry
+		var createdAt time.Time
+		if err := rows.Scan(&entry.Id, &entry.OrderId, &entry.FromStatus, &entry.ToStatus, &entry.Reason, &createdAt); err != nil {
+			return nil, fmt.Errorf("scan error: %w", err)
+		}
+		entry.CreatedAt = timestamppb.New(createdAt)
+		history = append(history, &entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	if len(history) == 0 {
+		return nil, pgx.ErrNoRows
+	}
+	return history, nil
+}
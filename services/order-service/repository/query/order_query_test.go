@@ -0,0 +1,112 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	"github.com/daffaromero/retries/services/common/migrate/migratetest"
+	"github.com/daffaromero/retries/services/order-service/migrations"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func testOrder(id, customerID string, createdAt time.Time) *pb.Order {
+	return &pb.Order{
+		Id:               id,
+		CustomerId:       customerID,
+		ProductIds:       []string{"a", "b"},
+		ProductsDetails:  []*pb.ProductDetails{{Id: "a", Name: "A", Price: 100}, {Id: "b", Name: "B", Price: 200}},
+		SettlementStatus: "created",
+		TotalPayment:     300,
+		AdminFee:         10,
+		GrandTotal:       310,
+		CustomerEmail:    "customer@example.com",
+		PriceBreakdown:   &pb.PriceBreakdown{Subtotal: 300, TotalFees: 10, GrandTotal: 310},
+		// Postgres keeps microseconds.
+		CreatedAt: timestamppb.New(createdAt.Truncate(time.Microsecond)),
+		UpdatedAt: timestamppb.New(createdAt.Truncate(time.Microsecond)),
+	}
+}
+
+// createOrders stores orders in one transaction.
+func createOrders(t *testing.T, db *pgxpool.Pool, q OrderQuery, orders ...*pb.Order) {
+	t.Helper()
+	ctx := context.Background()
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback(ctx)
+	for _, ord := range orders {
+		if _, err := q.CreateOrder(ctx, tx, ord); err != nil {
+			t.Fatalf("CreateOrder: %v", err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOrderRoundTrip(t *testing.T) {
+	db := migratetest.DB(t, "order-service", migrations.FS)
+	q := NewOrderQueryImpl(db)
+	ctx := context.Background()
+
+	now := time.Now()
+	older := testOrder("order-1", "customer-1", now.Add(-time.Hour))
+	newer := testOrder("order-2", "customer-1", now)
+	createOrders(t, db, q, older, newer)
+
+	res, err := q.GetOrderDetails(ctx, &pb.GetOrderFilter{OrderId: "order-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Orders) != 1 || !proto.Equal(res.Orders[0], older) {
+		t.Errorf("GetOrderDetails = %v, want %v", res.Orders, older)
+	}
+
+	res, err = q.GetOrderDetails(ctx, &pb.GetOrderFilter{CustomerId: "customer-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Orders) != 2 || res.Orders[0].Id != "order-2" || !res.Orders[0].CreatedAt.AsTime().Equal(newer.CreatedAt.AsTime()) {
+		t.Errorf("orders of customer-1 = %v, want order-2 then order-1", res.Orders)
+	}
+
+	if _, err := q.GetOrderDetails(ctx, &pb.GetOrderFilter{OrderId: "order-missing"}); !errors.Is(err, pgx.ErrNoRows) {
+		t.Errorf("GetOrderDetails(missing) = %v, want pgx.ErrNoRows", err)
+	}
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback(ctx)
+	list, err := q.GetOrders(ctx, tx, &pb.GetOrdersRequest{
+		CustomerId: "customer-1",
+		Pagination: &pb.Pagination{Limit: 1, WithTotal: true},
+		Sorting:    &pb.Sorting{OrderBy: "created_at"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Orders) != 1 || list.Total != 2 || list.NextCursor == "" {
+		t.Fatalf("first page = %v, total %d, cursor %q", list.Orders, list.Total, list.NextCursor)
+	}
+	next, err := q.GetOrders(ctx, tx, &pb.GetOrdersRequest{
+		CustomerId: "customer-1",
+		Pagination: &pb.Pagination{Limit: 1, Cursor: list.NextCursor},
+		Sorting:    &pb.Sorting{OrderBy: "created_at"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(next.Orders) != 1 || next.Orders[0].Id == list.Orders[0].Id {
+		t.Errorf("second page = %v after %v", next.Orders, list.Orders)
+	}
+}
@@ -0,0 +1,58 @@
+package query
+
+import (
+	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	"github.com/daffaromero/retries/services/common/sqlbuilder"
+)
+
+const defaultOrderLimit = 20
+
+// orderSorts are the keys orders can be sorted by.
+var orderSorts = map[string]string{
+	"created_at":        "created_at",
+	"updated_at":        "updated_at",
+	"settlement_status": "settlement_status",
+	"total_payment":     "COALESCE(total_payment, 0)",
+	"grand_total":       "COALESCE(grand_total, 0)",
+}
+
+// OrderFilters builds the order listing query for req.
+func OrderFilters(req *pb.GetOrdersRequest) (*sqlbuilder.Query, error) {
+	q := sqlbuilder.Select(orderColumns, "orders", "id")
+
+	if req.CustomerId != "" {
+		q.Eq("customer_id", req.CustomerId)
+	}
+	if req.Search != "" {
+		pattern := sqlbuilder.Contains(req.Search)
+		q.Where("(id ILIKE ? OR customer_email ILIKE ?)", pattern, pattern)
+	}
+
+	if err := q.Sort(orderSorts, req.Sorting.GetOrderBy(), req.Sorting.GetIsReversed()); err != nil {
+		return nil, err
+	}
+	p := req.Pagination
+	q.Paginate(sqlbuilder.Page(p.GetPage(), p.GetLimit(), p.GetOffset(), defaultOrderLimit))
+	if err := q.After(p.GetCursor()); err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
+
+// orderKey returns the value of o that sort orders by, and its id.
+func orderKey(o *pb.Order, sort string) (any, string) {
+	switch sort {
+	case "created_at":
+		return o.CreatedAt, o.Id
+	case "updated_at":
+		return o.UpdatedAt, o.Id
+	case "settlement_status":
+		return o.SettlementStatus, o.Id
+	case "total_payment":
+		return o.TotalPayment, o.Id
+	case "grand_total":
+		return o.GrandTotal, o.Id
+	}
+	return nil, o.Id
+}
@@ -0,0 +1,64 @@
+package query
+
+import (
+	"errors"
+	"testing"
+
+	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	"github.com/daffaromero/retries/services/common/sqlbuilder"
+	"github.com/daffaromero/retries/services/common/sqlbuilder/sqltest"
+)
+
+func TestOrderFilters(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *pb.GetOrdersRequest
+	}{
+		{"orders_default", &pb.GetOrdersRequest{}},
+		{"orders_customer_sorted", &pb.GetOrdersRequest{
+			CustomerId: "customer-1",
+			Search:     "@example.com",
+			Pagination: &pb.Pagination{Page: 2, Limit: 25},
+			Sorting:    &pb.Sorting{OrderBy: "created_at", IsReversed: true},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := OrderFilters(tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sql, args := q.Build()
+			sqltest.Golden(t, tt.name, sql, args)
+		})
+	}
+}
+
+func TestOrderFiltersCursor(t *testing.T) {
+	req := &pb.GetOrdersRequest{
+		CustomerId: "customer-1",
+		Pagination: &pb.Pagination{Limit: 1},
+		Sorting:    &pb.Sorting{OrderBy: "grand_total", IsReversed: true},
+	}
+	q, err := OrderFilters(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	orders := []*pb.Order{{Id: "o2", GrandTotal: 900}, {Id: "o1", GrandTotal: 300}}
+	_, next := sqlbuilder.Next(q, orders, orderKey)
+
+	req.Pagination.Cursor = next
+	q, err = OrderFilters(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sql, args := q.Build()
+	sqltest.Golden(t, "orders_after_cursor", sql, args)
+}
+
+func TestOrderFiltersRejectUnknownSort(t *testing.T) {
+	req := &pb.GetOrdersRequest{Sorting: &pb.Sorting{OrderBy: "customer_email"}}
+	if _, err := OrderFilters(req); !errors.Is(err, sqlbuilder.ErrInvalidFilter) {
+		t.Errorf("err = %v, want ErrInvalidFilter", err)
+	}
+}
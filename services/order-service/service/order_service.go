@@ -2,105 +2,100 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"errors"
 	"fmt"
-	"math"
+	"slices"
 
 	"github.com/daffaromero/retries/services/common/discovery"
 	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	pbPay "github.com/daffaromero/retries/services/common/genproto/payment"
+	"github.com/daffaromero/retries/services/common/resilience"
+	"github.com/daffaromero/retries/services/common/sqlbuilder"
 	"github.com/daffaromero/retries/services/common/utils/logger"
+	"github.com/daffaromero/retries/services/order-service/config"
+	"github.com/daffaromero/retries/services/order-service/pricing"
 	"github.com/daffaromero/retries/services/order-service/repository"
 
 	"github.com/gofiber/fiber/v3"
-	"github.com/stripe/stripe-go/v79"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type OrderService interface {
-	CreateOrder(c context.Context, ord *pb.Order, id string, name string, phone string, email string) (*pb.Order, error)
+	CreateOrder(context.Context, *pb.Order) (*pb.Order, error)
 	GetOrderDetails(context.Context, *pb.GetOrderFilter) (*pb.GetOrderResponse, error)
 	GetAllOrders(context.Context, *pb.GetOrdersRequest) (*pb.GetOrderResponse, error)
-	SendOrder(context.Context, *pb.SendOrderRequest) (*stripe.PaymentLink, error)
+	UpdateOrder(context.Context, *pb.Order) (*pb.Order, error)
+	SendOrder(context.Context, *pb.SendOrderRequest) (*pb.SendOrderResponse, error)
+	SettleOrder(context.Context, *pb.SettleOrderRequest) (*pb.Order, error)
+	GetOrderHistory(context.Context, *pb.GetOrderHistoryRequest) (*pb.GetOrderHistoryResponse, error)
+	CancelOrder(context.Context, *pb.CancelOrderRequest) (*pb.Order, error)
+	RefundOrder(context.Context, *pb.RefundOrderRequest) (*pb.RefundOrderResponse, error)
 }
 
 type orderService struct {
-	client   pb.ProductServiceClient
-	registry discovery.Registry
-	ordRepo  repository.OrderRepository
-	logger   *logger.Log
+	client        pb.ProductServiceClient
+	pricing       *pricing.Engine
+	paymentClient pbPay.PaymentServiceClient
+	registry      discovery.Registry
+	ordRepo       repository.OrderRepository
+	logger        *logger.Log
 }
 
-func NewOrderService(ctx context.Context, registry discovery.Registry, ordRepo repository.OrderRepository, logger *logger.Log) (*orderService, error) {
-	conn, err := discovery.ConnectToService(ctx, "product-service-grpc", registry)
+func NewOrderService(ctx context.Context, registry discovery.Registry, ordRepo repository.OrderRepository, pricingConfig config.PricingConfig, logger *logger.Log) (OrderService, error) {
+	conn, err := discovery.ConnectToService(ctx, discovery.ProductServiceName, registry)
 	if err != nil {
-		logger.Error(fmt.Sprintf("Failed to connect to product service", err))
+		logger.Error(fmt.Sprintf("Failed to connect to product service: %v", err))
 		return nil, err
 	}
+	payConn, err := discovery.ConnectToService(ctx, discovery.PaymentServiceName, registry)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to connect to payment service: %v", err))
+		return nil, err
+	}
+	client := pb.NewProductServiceClient(conn)
+	engine := pricing.NewEngine(pricing.NewCatalog(client), pricingConfig.MinimumSubtotal,
+		pricing.PercentageFee{
+			Label:   "Admin fee",
+			Percent: pricingConfig.AdminFeePercent,
+			Min:     pricingConfig.AdminFeeMin,
+			Max:     pricingConfig.AdminFeeMax,
+		},
+		pricing.FlatFee{Label: "Service fee", Amount: pricingConfig.AdminFeeFlat},
+	)
 	return &orderService{
-		client:   pb.NewProductServiceClient(conn),
-		registry: registry,
-		ordRepo:  ordRepo,
-		logger:   logger,
+		client:        client,
+		pricing:       engine,
+		paymentClient: pbPay.NewPaymentServiceClient(payConn),
+		registry:      registry,
+		ordRepo:       ordRepo,
+		logger:        logger,
 	}, nil
 }
 
 func (o *orderService) CreateOrder(c context.Context, ord *pb.Order) (*pb.Order, error) {
-	var prDet []*pb.ProductDetails
-	var prIds []string
-	var total int
-
-	if o.client == nil {
-		o.logger.Error("Product service client is not initialized")
-		return nil, fiber.NewError(fiber.StatusInternalServerError, "Failed to create order, please try again.")
-	}
-
-	if len(ord.ProductIds) == 0 {
-		for _, p := range ord.ProductsDetails {
-			product, err := o.client.GetProductByID(c, &pb.GetProductFilter{Id: p.Id})
-			if err != nil {
-				return nil, fiber.NewError(fiber.StatusNotFound, "No product found with ID %v", p.Id)
-			}
-
-			if p.Voucher != "" {
-				for _, prod := range product.Products {
-					if prod.Price <= 5 {
-						return nil, fiber.NewError(fiber.StatusNotFound, "Vouchers can not be applied to products lesser than 5 dollars")
-					}
-					if p.Voucher != prod.Voucher {
-						return nil, fiber.NewError(fiber.StatusNotFound, "Voucher not applicable.")
-					}
-
-					p.Price -= (p.Price * p.VoucherDiscount / 100)
-				}
-			}
-
-			prDet = append(prDet, &pb.ProductDetails{
-				Id:         p.Id,
-				Name:       p.Name,
-				Category:   p.Category,
-				SellerName: p.SellerName,
-				Price:      p.Price,
-			})
-			total += int(p.Price)
-			prIds = append(prIds, p.Id)
-		}
-
-		if total < 5 {
-			return nil, fiber.NewError(fiber.StatusBadRequest, "Total can not be less than 5 dollars")
-		} else {
-			total = 0
+	items := ord.ProductsDetails
+	if len(items) == 0 {
+		for _, id := range ord.ProductIds {
+			items = append(items, &pb.ProductDetails{Id: id})
 		}
 	}
 
-	if total != 0 {
-		if (float64(total) * 2 / 100) > 5 {
-			adminFee := float64(total) * 2 / 100
-			ceilFee := math.Ceil(adminFee)
-			ord.AdminFee = int32(ceilFee)
-			ord.GrandTotal = int32(total + int(ceilFee))
-		}
-	} else {
-		ord.AdminFee = 0
-		ord.GrandTotal = 0
+	quote, err := o.pricing.Quote(c, items)
+	if err != nil {
+		o.logger.CustomError("Failed to price order", err)
+		return nil, pricingError(err)
 	}
+	applyQuote(ord, quote)
+
+	now := timestamppb.Now()
+	ord.Id = uuid.New().String()
+	ord.SettlementStatus = StatusCreated
+	ord.CreatedAt = now
+	ord.UpdatedAt = now
 
 	res, err := o.ordRepo.CreateOrder(c, ord)
 	if err != nil {
@@ -110,6 +105,19 @@ func (o *orderService) CreateOrder(c context.Context, ord *pb.Order) (*pb.Order,
 	return res, nil
 }
 
+// applyQuote sets an order's items and totals from a price quote.
+func applyQuote(ord *pb.Order, quote *pricing.Quote) {
+	ord.ProductsDetails = quote.Items
+	ord.ProductIds = make([]string, 0, len(quote.Items))
+	for _, it := range quote.Items {
+		ord.ProductIds = append(ord.ProductIds, it.Id)
+	}
+	ord.PriceBreakdown = quote.Breakdown
+	ord.TotalPayment = quote.Breakdown.Subtotal
+	ord.AdminFee = quote.Breakdown.TotalFees
+	ord.GrandTotal = quote.Breakdown.GrandTotal
+}
+
 func (o *orderService) GetOrderDetails(ctx context.Context, filter *pb.GetOrderFilter) (*pb.GetOrderResponse, error) {
 	order, err := o.ordRepo.GetOrderDetails(ctx, filter)
 	if err != nil {
@@ -121,18 +129,473 @@ func (o *orderService) GetOrderDetails(ctx context.Context, filter *pb.GetOrderF
 
 func (o *orderService) GetAllOrders(ctx context.Context, req *pb.GetOrdersRequest) (*pb.GetOrderResponse, error) {
 	orders, err := o.ordRepo.GetAllOrders(ctx, req)
-	if err != nil {
+	if errors.Is(err, sqlbuilder.ErrInvalidFilter) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if err != nil {
 		o.logger.CustomError("failed to get all orders", err)
 		return nil, err
 	}
 	return orders, nil
 }
 
-func (o *orderService) SendOrder(ctx context.Context, req *pb.SendOrderRequest) (*stripe.PaymentLink, error) {
-	link, err := o.ordRepo.SendOrder(ctx, req)
+// UpdateOrder changes the customer, items or settlement status of an order.
+// The customer and items can only change before a payment link is sent, and
+// new items are priced again. Status changes must be allowed by the order
+// lifecycle; those that move money go through SendOrder, CancelOrder,
+// RefundOrder or payment settlement instead.
+func (o *orderService) UpdateOrder(ctx context.Context, req *pb.Order) (*pb.Order, error) {
+	items := req.ProductsDetails
+	if len(items) == 0 {
+		for _, id := range req.ProductIds {
+			items = append(items, &pb.ProductDetails{Id: id})
+		}
+	}
+	// Priced before the order is locked, so the row lock is not held across
+	// a call to product-service.
+	var quote *pricing.Quote
+	if len(items) > 0 {
+		q, err := o.pricing.Quote(ctx, items)
+		if err != nil {
+			o.logger.CustomError("Failed to price order", err)
+			return nil, pricingError(err)
+		}
+		quote = q
+	}
+
+	ord, err := o.ordRepo.ModifyOrder(ctx, req.Id, "order updated", func(ord *pb.Order) error {
+		customerChanged := req.CustomerId != "" && req.CustomerId != ord.CustomerId
+		if (customerChanged || quote != nil) && ord.SettlementStatus != StatusCreated {
+			return fiber.NewError(fiber.StatusConflict, "Customer and items can only change before a payment link is sent.")
+		}
+		if customerChanged {
+			ord.CustomerId = req.CustomerId
+		}
+		if quote != nil {
+			applyQuote(ord, quote)
+		}
+
+		if to := req.SettlementStatus; to != "" && to != ord.SettlementStatus {
+			if err := checkTransition(ord.SettlementStatus, to); err != nil {
+				return err
+			}
+			if !updatableStatus(to) {
+				return fiber.NewError(fiber.StatusConflict, fmt.Sprintf("Order can not be moved to %q by an update.", to))
+			}
+			ord.SettlementStatus = to
+		}
+		return nil
+	})
+	if err != nil {
+		o.logger.CustomError("Failed to update order", err)
+		return nil, err
+	}
+	return ord, nil
+}
+
+// SendOrder issues a payment link for the order and moves it to
+// pending_payment. The payment is requested under a key naming the order, so
+// concurrent or retried sends share one link. When the move fails and the
+// order does not hold that link, the payment is cancelled again rather than
+// left open.
+func (o *orderService) SendOrder(ctx context.Context, req *pb.SendOrderRequest) (*pb.SendOrderResponse, error) {
+	ords, err := o.ordRepo.GetOrderDetails(ctx, &pb.GetOrderFilter{OrderId: req.OrderId})
 	if err != nil {
+		o.logger.CustomError("Failed to get order to send", err)
+		return nil, err
+	}
+	ord := ords.Orders[0]
+	// Checked up front so no payment link is issued for an order that can not
+	// take one; the repository checks again under the row lock.
+	if err := checkTransition(ord.SettlementStatus, StatusPendingPayment); err != nil {
+		return nil, err
+	}
+
+	amount := ord.GrandTotal
+	if amount == 0 {
+		amount = ord.TotalPayment
+	}
+	pay, err := o.paymentClient.CreatePayment(ctx, &pbPay.CreatePaymentRequest{
+		OrderId:        ord.Id,
+		CustomerId:     ord.CustomerId,
+		Amount:         float64(amount),
+		LineItems:      lineItems(ord),
+		CustomerEmail:  ord.CustomerEmail,
+		IdempotencyKey: "send-" + ord.Id,
+	})
+	if err != nil {
+		o.logger.CustomError("Failed to create payment", err)
+		if errors.Is(err, resilience.ErrUnavailable) {
+			return nil, err
+		}
+		return nil, fiber.NewError(fiber.StatusServiceUnavailable, "Failed to create payment, please try again.")
+	}
+
+	t := repository.Transition{
+		OrderID: ord.Id,
+		To:      StatusPendingPayment,
+		Reason:  "payment " + pay.Id + " requested",
+		Check:   checkTransition,
+	}
+	if err := o.ordRepo.SendOrder(ctx, t, pay.PaymentLink); err != nil {
 		o.logger.CustomError("Failed to send order", err)
+		// A concurrent send with the same link got there first.
+		if ords, getErr := o.ordRepo.GetOrderDetails(ctx, &pb.GetOrderFilter{OrderId: ord.Id}); getErr == nil &&
+			ords.Orders[0].SettlementStatus == StatusPendingPayment && ords.Orders[0].PaymentLink == pay.PaymentLink {
+			return &pb.SendOrderResponse{PaymentLink: pay.PaymentLink}, nil
+		}
+		if _, cancelErr := o.paymentClient.CancelPayment(ctx, &pbPay.CancelPaymentRequest{OrderId: ord.Id}); cancelErr != nil && status.Code(cancelErr) != codes.NotFound {
+			o.logger.CustomError(fmt.Sprintf("Failed to cancel payment %s of unsent order %s", pay.Id, ord.Id), cancelErr)
+		}
 		return nil, err
 	}
-	return link, nil
-}
\ No newline at end of file
+	return &pb.SendOrderResponse{PaymentLink: pay.PaymentLink}, nil
+}
+
+// lineItems itemizes what the customer is charged for an order: one line per
+// product at its stored price, plus one per fee in the price breakdown.
+func lineItems(ord *pb.Order) []*pbPay.LineItem {
+	items := make([]*pbPay.LineItem, 0, len(ord.ProductsDetails)+1)
+	for _, p := range ord.ProductsDetails {
+		name := p.Name
+		if name == "" {
+			name = p.Id
+		}
+		items = append(items, &pbPay.LineItem{
+			Name:       name,
+			UnitAmount: float64(p.Price),
+			Quantity:   1,
+		})
+	}
+	if ord.PriceBreakdown != nil {
+		for _, fee := range ord.PriceBreakdown.Fees {
+			items = append(items, &pbPay.LineItem{
+				Name:       fee.Name,
+				UnitAmount: float64(fee.Amount),
+				Quantity:   1,
+			})
+		}
+		return items
+	}
+	if ord.AdminFee > 0 {
+		items = append(items, &pbPay.LineItem{
+			Name:       "Admin fee",
+			UnitAmount: float64(ord.AdminFee),
+			Quantity:   1,
+		})
+	}
+	return items
+}
+
+// SettleOrder records the outcome of a payment reported by payment-service.
+// Reports that do not change the order, such as a repeated webhook delivery
+// or a declined charge, return the order as it is.
+func (o *orderService) SettleOrder(ctx context.Context, req *pb.SettleOrderRequest) (*pb.Order, error) {
+	ords, err := o.ordRepo.GetOrderDetails(ctx, &pb.GetOrderFilter{OrderId: req.OrderId})
+	if err != nil {
+		o.logger.CustomError("Failed to get order to settle", err)
+		return nil, err
+	}
+	ord := ords.Orders[0]
+
+	to, ok := settlementStatus(req.SettlementStatus)
+	if !ok || to == ord.SettlementStatus {
+		return ord, nil
+	}
+	// A session voided by CancelOrder still reports its expiry afterwards.
+	if (to == StatusExpired || to == StatusCancelled) && ord.SettlementStatus != StatusPendingPayment {
+		return ord, nil
+	}
+
+	reason := "payment " + req.SettlementStatus
+	if req.PaymentId != "" {
+		reason = "payment " + req.PaymentId + " " + req.SettlementStatus
+	}
+	res, err := o.ordRepo.UpdateStatus(ctx, repository.Transition{
+		OrderID: req.OrderId,
+		To:      to,
+		Reason:  reason,
+		Check:   checkTransition,
+	})
+	if err != nil {
+		o.logger.CustomError("Failed to settle order", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (o *orderService) GetOrderHistory(ctx context.Context, req *pb.GetOrderHistoryRequest) (*pb.GetOrderHistoryResponse, error) {
+	history, err := o.ordRepo.GetOrderHistory(ctx, req.OrderId)
+	if err != nil {
+		o.logger.CustomError("Failed to get order history", err)
+		return nil, err
+	}
+	return &pb.GetOrderHistoryResponse{History: history}, nil
+}
+
+// CancelOrder cancels an order that has not been paid yet, voiding its
+// payment link first if one was sent. Payment-service is called outside the
+// order's transaction; the move is checked again under the row lock when the
+// cancellation is written.
+func (o *orderService) CancelOrder(ctx context.Context, req *pb.CancelOrderRequest) (*pb.Order, error) {
+	reason := req.Reason
+	if reason == "" {
+		reason = "order cancelled"
+	}
+	ords, err := o.ordRepo.GetOrderDetails(ctx, &pb.GetOrderFilter{OrderId: req.OrderId})
+	if err != nil {
+		o.logger.CustomError("Failed to get order to cancel", err)
+		return nil, err
+	}
+	current := ords.Orders[0]
+	if err := checkTransition(current.SettlementStatus, StatusCancelled); err != nil {
+		return nil, err
+	}
+
+	if current.SettlementStatus == StatusPendingPayment {
+		_, err := o.paymentClient.CancelPayment(ctx, &pbPay.CancelPaymentRequest{OrderId: current.Id})
+		if err != nil && status.Code(err) != codes.NotFound {
+			o.logger.CustomError("Failed to cancel payment", err)
+			return nil, paymentError(err, "Failed to void payment link, please try again.")
+		}
+	}
+
+	ord, err := o.ordRepo.ModifyOrder(ctx, req.OrderId, reason, func(ord *pb.Order) error {
+		if err := checkTransition(ord.SettlementStatus, StatusCancelled); err != nil {
+			return err
+		}
+		ord.SettlementStatus = StatusCancelled
+		return nil
+	})
+	if err != nil {
+		o.logger.CustomError("Failed to cancel order", err)
+		return nil, err
+	}
+	return ord, nil
+}
+
+// RefundOrder refunds the given product lines of a paid order, or every line
+// still unrefunded when none are given. The admin fee goes back with the last
+// line, at which point the order becomes refunded.
+//
+// The lines are first reserved under the order's row lock, marked with the
+// refund's idempotency key, so a concurrent request for any of them is turned
+// away before payment-service is called. The key names the order and lines:
+// a retried request finds its own reservation and payment-service returns
+// the refund it already made. A failed refund gives the lines back.
+func (o *orderService) RefundOrder(ctx context.Context, req *pb.RefundOrderRequest) (*pb.RefundOrderResponse, error) {
+	reason := req.Reason
+	if reason == "" {
+		reason = "order refunded"
+	}
+	var (
+		lines  []int
+		key    string
+		amount int32
+	)
+	_, err := o.ordRepo.ModifyOrder(ctx, req.OrderId, reason, func(ord *pb.Order) error {
+		if err := checkTransition(ord.SettlementStatus, StatusRefunded); err != nil {
+			return err
+		}
+		var err error
+		lines, err = refundLines(ord, req.ProductIds)
+		if err != nil {
+			return err
+		}
+		key = refundKey(ord.Id, lines)
+		amount = refundAmount(ord, lines)
+		return reserveLines(ord, lines, key)
+	})
+	if err != nil {
+		o.logger.CustomError("Failed to reserve order lines to refund", err)
+		return nil, err
+	}
+
+	var refundID string
+	if amount > 0 {
+		res, err := o.paymentClient.RefundPayment(ctx, &pbPay.RefundPaymentRequest{
+			OrderId:        req.OrderId,
+			Amount:         float64(amount),
+			IdempotencyKey: key,
+		})
+		if err != nil {
+			o.logger.CustomError("Failed to refund payment", err)
+			if _, relErr := o.ordRepo.ModifyOrder(ctx, req.OrderId, reason, func(ord *pb.Order) error {
+				releaseLines(ord, key)
+				return nil
+			}); relErr != nil {
+				o.logger.CustomError(fmt.Sprintf("Failed to release lines of order %s reserved for refund %s", req.OrderId, key), relErr)
+			}
+			return nil, paymentError(err, "Failed to refund payment, please try again.")
+		}
+		refundID = res.RefundId
+	}
+
+	ord, err := o.ordRepo.ModifyOrder(ctx, req.OrderId, reason, func(ord *pb.Order) error {
+		if err := checkTransition(ord.SettlementStatus, StatusRefunded); err != nil {
+			return err
+		}
+		if err := reserveLines(ord, lines, key); err != nil {
+			return err
+		}
+		return applyRefund(ord, lines, amount)
+	})
+	if err != nil {
+		o.logger.CustomError(fmt.Sprintf("Failed to record refund %q of order %s", refundID, req.OrderId), err)
+		return nil, err
+	}
+	return &pb.RefundOrderResponse{
+		Order:          ord,
+		RefundId:       refundID,
+		RefundedAmount: amount,
+	}, nil
+}
+
+// reserveLines marks lines as held by the refund under key. A line already
+// held by another refund is a conflict; one held under key is left as is.
+func reserveLines(ord *pb.Order, lines []int, key string) error {
+	for _, i := range lines {
+		p := ord.ProductsDetails[i]
+		if p.RefundKey != "" && p.RefundKey != key {
+			return fiber.NewError(fiber.StatusConflict, fmt.Sprintf("Product %s is already being refunded.", p.Id))
+		}
+	}
+	for _, i := range lines {
+		ord.ProductsDetails[i].RefundKey = key
+	}
+	return nil
+}
+
+// releaseLines frees the lines held by the refund under key that it did not
+// refund.
+func releaseLines(ord *pb.Order, key string) {
+	for _, p := range ord.ProductsDetails {
+		if p.RefundKey == key && !p.Refunded {
+			p.RefundKey = ""
+		}
+	}
+}
+
+// refundLines picks the lines of ord to refund, as indexes into
+// ProductsDetails. Each ID in productIDs takes one unrefunded line of that
+// product, so an ID given twice refunds two of its lines. An empty
+// productIDs takes every unrefunded line.
+func refundLines(ord *pb.Order, productIDs []string) ([]int, error) {
+	var lines []int
+	if len(productIDs) == 0 {
+		for i, p := range ord.ProductsDetails {
+			if !p.Refunded {
+				lines = append(lines, i)
+			}
+		}
+		return lines, nil
+	}
+
+	taken := make(map[int]bool, len(productIDs))
+	for _, id := range productIDs {
+		line, found := -1, false
+		for i, p := range ord.ProductsDetails {
+			if p.Id != id {
+				continue
+			}
+			found = true
+			if !p.Refunded && !taken[i] {
+				line = i
+				break
+			}
+		}
+		switch {
+		case !found:
+			return nil, fiber.NewError(fiber.StatusBadRequest, fmt.Sprintf("Product %s is not part of this order.", id))
+		case line < 0:
+			return nil, fiber.NewError(fiber.StatusConflict, fmt.Sprintf("Product %s has already been refunded.", id))
+		}
+		taken[line] = true
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+// refundAmount is what refunding lines returns to the customer: their
+// prices, plus the admin fee when they are the last unrefunded lines.
+func refundAmount(ord *pb.Order, lines []int) int32 {
+	var amount int32
+	for _, i := range lines {
+		amount += ord.ProductsDetails[i].Price
+	}
+	if unrefunded(ord) == len(lines) {
+		amount += ord.AdminFee
+	}
+	return amount
+}
+
+// applyRefund marks lines refunded and takes amount, as returned by
+// refundAmount, off the order's totals. The order becomes refunded once no
+// line is left.
+func applyRefund(ord *pb.Order, lines []int, amount int32) error {
+	var prices int32
+	for _, i := range lines {
+		p := ord.ProductsDetails[i]
+		if p.Refunded {
+			return fiber.NewError(fiber.StatusConflict, fmt.Sprintf("Product %s has already been refunded.", p.Id))
+		}
+		p.Refunded = true
+		prices += p.Price
+	}
+	ord.TotalPayment = max(ord.TotalPayment-prices, 0)
+	ord.GrandTotal = max(ord.GrandTotal-amount, 0)
+	if unrefunded(ord) == 0 {
+		ord.SettlementStatus = StatusRefunded
+	}
+	return nil
+}
+
+func unrefunded(ord *pb.Order) int {
+	n := 0
+	for _, p := range ord.ProductsDetails {
+		if !p.Refunded {
+			n++
+		}
+	}
+	return n
+}
+
+// refundKey is the idempotency key for refunding lines of an order.
+func refundKey(orderID string, lines []int) string {
+	sorted := slices.Clone(lines)
+	slices.Sort(sorted)
+	h := sha256.New()
+	for _, i := range sorted {
+		fmt.Fprintf(h, "%d,", i)
+	}
+	return fmt.Sprintf("refund-%s-%x", orderID, h.Sum(nil)[:8])
+}
+
+// pricingError turns a pricing failure into the error reported to the client.
+func pricingError(err error) error {
+	switch {
+	case errors.Is(err, resilience.ErrUnavailable):
+		return err
+	case errors.Is(err, pricing.ErrProductNotFound):
+		return fiber.NewError(fiber.StatusNotFound, err.Error())
+	case errors.Is(err, pricing.ErrNoItems),
+		errors.Is(err, pricing.ErrVoucherNotApplicable),
+		errors.Is(err, pricing.ErrBelowMinimumSubtotal):
+		return fiber.NewError(fiber.StatusBadRequest, err.Error())
+	default:
+		return fiber.NewError(fiber.StatusServiceUnavailable, "Failed to price order, please try again.")
+	}
+}
+
+// paymentError turns a failed payment-service call into the error reported
+// to the client.
+func paymentError(err error, msg string) error {
+	if errors.Is(err, resilience.ErrUnavailable) {
+		return err
+	}
+	switch status.Code(err) {
+	case codes.NotFound, codes.FailedPrecondition:
+		return fiber.NewError(fiber.StatusConflict, "The order's payment is not in a state that allows this.")
+	default:
+		return fiber.NewError(fiber.StatusServiceUnavailable, msg)
+	}
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// Settlement statuses an order moves through. An order starts as created,
+// waits in pending_payment once a payment link has been sent, and ends in one
+// of the terminal states below.
+const (
+	StatusCreated        = "created"
+	StatusPendingPayment = "pending_payment"
+	StatusPaid           = "paid"
+	StatusFulfilled      = "fulfilled"
+	StatusCancelled      = "cancelled"
+	StatusExpired        = "expired"
+	StatusRefunded       = "refunded"
+)
+
+var transitions = map[string][]string{
+	StatusCreated:        {StatusPendingPayment, StatusCancelled},
+	StatusPendingPayment: {StatusPaid, StatusCancelled, StatusExpired},
+	StatusPaid:           {StatusFulfilled, StatusRefunded},
+	StatusFulfilled:      {StatusRefunded},
+}
+
+// checkTransition rejects any move the order lifecycle does not allow.
+func checkTransition(from, to string) error {
+	for _, next := range transitions[from] {
+		if next == to {
+			return nil
+		}
+	}
+	return fiber.NewError(fiber.StatusConflict, fmt.Sprintf("Order can not move from %q to %q.", from, to))
+}
+
+// updatableStatus reports whether UpdateOrder may move an order to status.
+// The other statuses are reached through the payment flow, which keeps the
+// order and its payment in step.
+func updatableStatus(status string) bool {
+	return status == StatusFulfilled
+}
+
+// settlementStatus maps a payment status reported by payment-service onto the
+// order lifecycle. A failed charge leaves the order waiting for payment, since
+// the customer can retry on the same checkout link.
+func settlementStatus(paymentStatus string) (string, bool) {
+	switch paymentStatus {
+	case "paid":
+		return StatusPaid, true
+	case "expired":
+		return StatusExpired, true
+	case "canceled", "cancelled":
+		return StatusCancelled, true
+	case "refunded":
+		return StatusRefunded, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+var allStatuses = []string{
+	StatusCreated,
+	StatusPendingPayment,
+	StatusPaid,
+	StatusFulfilled,
+	StatusCancelled,
+	StatusExpired,
+	StatusRefunded,
+}
+
+func TestCheckTransition(t *testing.T) {
+	allowed := map[[2]string]bool{
+		{StatusCreated, StatusPendingPayment}:   true,
+		{StatusCreated, StatusCancelled}:        true,
+		{StatusPendingPayment, StatusPaid}:      true,
+		{StatusPendingPayment, StatusCancelled}: true,
+		{StatusPendingPayment, StatusExpired}:   true,
+		{StatusPaid, StatusFulfilled}:           true,
+		{StatusPaid, StatusRefunded}:            true,
+		{StatusFulfilled, StatusRefunded}:       true,
+	}
+
+	for _, from := range allStatuses {
+		for _, to := range allStatuses {
+			err := checkTransition(from, to)
+			if allowed[[2]string{from, to}] {
+				if err != nil {
+					t.Errorf("checkTransition(%s, %s) = %v, want allowed", from, to, err)
+				}
+				continue
+			}
+			var fe *fiber.Error
+			if !errors.As(err, &fe) || fe.Code != fiber.StatusConflict {
+				t.Errorf("checkTransition(%s, %s) = %v, want a 409", from, to, err)
+			}
+		}
+	}
+}
+
+func TestCheckTransitionUnknownStatus(t *testing.T) {
+	for _, tt := range [][2]string{{"", StatusCreated}, {"shipped", StatusRefunded}, {StatusPaid, "shipped"}} {
+		if err := checkTransition(tt[0], tt[1]); err == nil {
+			t.Errorf("checkTransition(%q, %q) allowed", tt[0], tt[1])
+		}
+	}
+}
+
+func TestUpdatableStatus(t *testing.T) {
+	for _, status := range allStatuses {
+		if got, want := updatableStatus(status), status == StatusFulfilled; got != want {
+			t.Errorf("updatableStatus(%s) = %v, want %v", status, got, want)
+		}
+	}
+}
+
+func TestSettlementStatus(t *testing.T) {
+	tests := []struct {
+		payment string
+		want    string
+		ok      bool
+	}{
+		{"paid", StatusPaid, true},
+		{"expired", StatusExpired, true},
+		{"canceled", StatusCancelled, true},
+		{"cancelled", StatusCancelled, true},
+		{"refunded", StatusRefunded, true},
+		{"pending", "", false},
+		{"failed", "", false},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := settlementStatus(tt.payment)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("settlementStatus(%q) = %q, %v, want %q, %v", tt.payment, got, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,339 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	pbPay "github.com/daffaromero/retries/services/common/genproto/payment"
+	"github.com/daffaromero/retries/services/common/utils/logger"
+	"github.com/daffaromero/retries/services/order-service/repository"
+	"github.com/gofiber/fiber/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+func paidOrder() *pb.Order {
+	return &pb.Order{
+		Id:               "order-1",
+		SettlementStatus: StatusPaid,
+		ProductsDetails: []*pb.ProductDetails{
+			{Id: "a", Price: 100},
+			{Id: "b", Price: 200},
+			{Id: "a", Price: 100},
+		},
+		TotalPayment: 400,
+		AdminFee:     10,
+		GrandTotal:   410,
+	}
+}
+
+func wantCode(t *testing.T, err error, code int) {
+	t.Helper()
+	var fe *fiber.Error
+	if !errors.As(err, &fe) || fe.Code != code {
+		t.Errorf("error = %v, want a %d", err, code)
+	}
+}
+
+func TestRefundLines(t *testing.T) {
+	tests := []struct {
+		name       string
+		refunded   []int
+		productIDs []string
+		want       []int
+		wantCode   int
+	}{
+		{name: "all", want: []int{0, 1, 2}},
+		{name: "all that are left", refunded: []int{0}, want: []int{1, 2}},
+		{name: "one", productIDs: []string{"b"}, want: []int{1}},
+		{name: "first line of a product", productIDs: []string{"a"}, want: []int{0}},
+		{name: "duplicate ID takes the next line", productIDs: []string{"a", "a"}, want: []int{0, 2}},
+		{name: "next unrefunded line", refunded: []int{0}, productIDs: []string{"a"}, want: []int{2}},
+		{name: "more lines than the order has", productIDs: []string{"a", "a", "a"}, wantCode: fiber.StatusConflict},
+		{name: "already refunded", refunded: []int{1}, productIDs: []string{"b"}, wantCode: fiber.StatusConflict},
+		{name: "not in the order", productIDs: []string{"c"}, wantCode: fiber.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ord := paidOrder()
+			for _, i := range tt.refunded {
+				ord.ProductsDetails[i].Refunded = true
+			}
+			got, err := refundLines(ord, tt.productIDs)
+			if tt.wantCode != 0 {
+				wantCode(t, err, tt.wantCode)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("lines = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyRefund(t *testing.T) {
+	ord := paidOrder()
+
+	amount := refundAmount(ord, []int{0, 2})
+	if amount != 200 {
+		t.Fatalf("refundAmount = %d, want 200", amount)
+	}
+	if err := applyRefund(ord, []int{0, 2}, amount); err != nil {
+		t.Fatal(err)
+	}
+	if ord.SettlementStatus != StatusPaid || ord.TotalPayment != 200 || ord.GrandTotal != 210 {
+		t.Errorf("after partial refund: %s, total %d, grand total %d", ord.SettlementStatus, ord.TotalPayment, ord.GrandTotal)
+	}
+
+	// The last line takes the admin fee with it.
+	amount = refundAmount(ord, []int{1})
+	if amount != 210 {
+		t.Fatalf("refundAmount of the last line = %d, want 210", amount)
+	}
+	if err := applyRefund(ord, []int{1}, amount); err != nil {
+		t.Fatal(err)
+	}
+	if ord.SettlementStatus != StatusRefunded || ord.TotalPayment != 0 || ord.GrandTotal != 0 {
+		t.Errorf("after full refund: %s, total %d, grand total %d", ord.SettlementStatus, ord.TotalPayment, ord.GrandTotal)
+	}
+
+	wantCode(t, applyRefund(ord, []int{1}, 0), fiber.StatusConflict)
+}
+
+func TestRefundKey(t *testing.T) {
+	if refundKey("order-1", []int{2, 0}) != refundKey("order-1", []int{0, 2}) {
+		t.Error("key depends on line order")
+	}
+	if refundKey("order-1", []int{0}) == refundKey("order-1", []int{2}) {
+		t.Error("different lines share a key")
+	}
+	if refundKey("order-1", []int{0}) == refundKey("order-2", []int{0}) {
+		t.Error("different orders share a key")
+	}
+}
+
+// fakeOrders holds one order and tracks whether a transaction on it is open.
+// SendOrder fails with sendErr when it is set, after running race.
+type fakeOrders struct {
+	repository.OrderRepository
+	order   *pb.Order
+	inTx    bool
+	sendErr error
+	race    func(*pb.Order)
+}
+
+func (f *fakeOrders) SendOrder(ctx context.Context, t repository.Transition, paymentLink string) error {
+	if f.race != nil {
+		f.race(f.order)
+	}
+	if f.sendErr != nil {
+		return f.sendErr
+	}
+	if err := t.Check(f.order.SettlementStatus, t.To); err != nil {
+		return err
+	}
+	f.order.SettlementStatus = t.To
+	f.order.PaymentLink = paymentLink
+	return nil
+}
+
+func (f *fakeOrders) GetOrderDetails(ctx context.Context, fil *pb.GetOrderFilter) (*pb.GetOrderResponse, error) {
+	return &pb.GetOrderResponse{Orders: []*pb.Order{proto.Clone(f.order).(*pb.Order)}}, nil
+}
+
+func (f *fakeOrders) ModifyOrder(ctx context.Context, orderID, reason string, apply func(*pb.Order) error) (*pb.Order, error) {
+	f.inTx = true
+	defer func() { f.inTx = false }()
+
+	ord := proto.Clone(f.order).(*pb.Order)
+	if err := apply(ord); err != nil {
+		return nil, err
+	}
+	f.order = ord
+	return ord, nil
+}
+
+// fakePayments fails the test when called with an order transaction open, or
+// asked to refund lines the order has not reserved. RefundPayment fails with
+// refundErr when it is set.
+type fakePayments struct {
+	pbPay.PaymentServiceClient
+	t         *testing.T
+	orders    *fakeOrders
+	refundErr error
+	refunds   []*pbPay.RefundPaymentRequest
+	created   []*pbPay.CreatePaymentRequest
+	cancels   int
+}
+
+func (f *fakePayments) CreatePayment(ctx context.Context, req *pbPay.CreatePaymentRequest, opts ...grpc.CallOption) (*pbPay.CreatePaymentResponse, error) {
+	f.created = append(f.created, req)
+	return &pbPay.CreatePaymentResponse{Id: "pay-1", Status: true, PaymentLink: "http://pay/1"}, nil
+}
+
+func (f *fakePayments) RefundPayment(ctx context.Context, req *pbPay.RefundPaymentRequest, opts ...grpc.CallOption) (*pbPay.RefundPaymentResponse, error) {
+	if f.orders.inTx {
+		f.t.Error("RefundPayment called inside the order's transaction")
+	}
+	if !slices.ContainsFunc(f.orders.order.ProductsDetails, func(p *pb.ProductDetails) bool { return p.RefundKey == req.IdempotencyKey }) {
+		f.t.Error("RefundPayment called before the lines were reserved")
+	}
+	if f.refundErr != nil {
+		return nil, f.refundErr
+	}
+	f.refunds = append(f.refunds, req)
+	return &pbPay.RefundPaymentResponse{RefundId: "re_1", Amount: req.Amount}, nil
+}
+
+func (f *fakePayments) CancelPayment(ctx context.Context, req *pbPay.CancelPaymentRequest, opts ...grpc.CallOption) (*pbPay.Payment, error) {
+	if f.orders.inTx {
+		f.t.Error("CancelPayment called inside the order's transaction")
+	}
+	f.cancels++
+	return &pbPay.Payment{OrderId: req.OrderId}, nil
+}
+
+func newTestService(t *testing.T, ord *pb.Order) (*orderService, *fakeOrders, *fakePayments) {
+	orders := &fakeOrders{order: ord}
+	payments := &fakePayments{t: t, orders: orders}
+	return &orderService{
+		paymentClient: payments,
+		ordRepo:       orders,
+		logger:        logger.NewLog("test"),
+	}, orders, payments
+}
+
+func TestRefundOrder(t *testing.T) {
+	o, orders, payments := newTestService(t, paidOrder())
+
+	res, err := o.RefundOrder(context.Background(), &pb.RefundOrderRequest{OrderId: "order-1", ProductIds: []string{"a", "a"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.RefundedAmount != 200 || res.RefundId != "re_1" {
+		t.Errorf("response = %d (%s), want 200 (re_1)", res.RefundedAmount, res.RefundId)
+	}
+	if len(payments.refunds) != 1 || payments.refunds[0].IdempotencyKey != refundKey("order-1", []int{0, 2}) {
+		t.Errorf("refund requests = %v", payments.refunds)
+	}
+	if !orders.order.ProductsDetails[0].Refunded || orders.order.ProductsDetails[1].Refunded || !orders.order.ProductsDetails[2].Refunded {
+		t.Errorf("refunded lines = %v", orders.order.ProductsDetails)
+	}
+
+	_, err = o.RefundOrder(context.Background(), &pb.RefundOrderRequest{OrderId: "order-1", ProductIds: []string{"a"}})
+	wantCode(t, err, fiber.StatusConflict)
+	if len(payments.refunds) != 1 {
+		t.Errorf("refund issued for a line already refunded")
+	}
+
+	if _, err := o.RefundOrder(context.Background(), &pb.RefundOrderRequest{OrderId: "order-1"}); err != nil {
+		t.Fatal(err)
+	}
+	if orders.order.SettlementStatus != StatusRefunded || payments.refunds[1].Amount != 210 {
+		t.Errorf("after refunding the rest: %s, refunded %v", orders.order.SettlementStatus, payments.refunds[1].Amount)
+	}
+}
+
+func TestRefundOrderReservesLines(t *testing.T) {
+	o, orders, payments := newTestService(t, paidOrder())
+	orders.order.ProductsDetails[0].RefundKey = "refund-order-1-other"
+
+	_, err := o.RefundOrder(context.Background(), &pb.RefundOrderRequest{OrderId: "order-1", ProductIds: []string{"a"}})
+	wantCode(t, err, fiber.StatusConflict)
+	if len(payments.refunds) != 0 {
+		t.Errorf("refund issued for a line another refund holds")
+	}
+
+	// A retry finds the lines its earlier attempt reserved.
+	key := refundKey("order-1", []int{1})
+	orders.order.ProductsDetails[1].RefundKey = key
+	if _, err := o.RefundOrder(context.Background(), &pb.RefundOrderRequest{OrderId: "order-1", ProductIds: []string{"b"}}); err != nil {
+		t.Fatalf("retried refund: %v", err)
+	}
+
+	orders.order.ProductsDetails[0].RefundKey = ""
+	payments.refundErr = errors.New("payment service down")
+	_, err = o.RefundOrder(context.Background(), &pb.RefundOrderRequest{OrderId: "order-1", ProductIds: []string{"a", "a"}})
+	wantCode(t, err, fiber.StatusServiceUnavailable)
+	for _, i := range []int{0, 2} {
+		if p := orders.order.ProductsDetails[i]; p.RefundKey != "" || p.Refunded {
+			t.Errorf("line %d of a failed refund left as %v", i, p)
+		}
+	}
+}
+
+func TestCancelOrder(t *testing.T) {
+	for _, tt := range []struct {
+		from        string
+		wantCancels int
+		wantCode    int
+	}{
+		{from: StatusCreated},
+		{from: StatusPendingPayment, wantCancels: 1},
+		{from: StatusPaid, wantCode: fiber.StatusConflict},
+	} {
+		t.Run(tt.from, func(t *testing.T) {
+			o, orders, payments := newTestService(t, &pb.Order{Id: "order-1", SettlementStatus: tt.from})
+
+			_, err := o.CancelOrder(context.Background(), &pb.CancelOrderRequest{OrderId: "order-1"})
+			if tt.wantCode != 0 {
+				wantCode(t, err, tt.wantCode)
+			} else if err != nil {
+				t.Fatal(err)
+			} else if orders.order.SettlementStatus != StatusCancelled {
+				t.Errorf("status = %s, want cancelled", orders.order.SettlementStatus)
+			}
+			if payments.cancels != tt.wantCancels {
+				t.Errorf("CancelPayment calls = %d, want %d", payments.cancels, tt.wantCancels)
+			}
+		})
+	}
+}
+
+func TestSendOrderCancelsUnsentPayment(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		race        func(*pb.Order)
+		wantErr     bool
+		wantCancels int
+	}{
+		{
+			name:        "cancelled meanwhile",
+			race:        func(ord *pb.Order) { ord.SettlementStatus = StatusCancelled },
+			wantErr:     true,
+			wantCancels: 1,
+		},
+		{
+			name: "sent meanwhile with the same link",
+			race: func(ord *pb.Order) {
+				ord.SettlementStatus = StatusPendingPayment
+				ord.PaymentLink = "http://pay/1"
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			o, orders, payments := newTestService(t, &pb.Order{Id: "order-1", SettlementStatus: StatusCreated, GrandTotal: 100})
+			orders.race = tt.race
+			orders.sendErr = fiber.NewError(fiber.StatusConflict, "order moved")
+
+			res, err := o.SendOrder(context.Background(), &pb.SendOrderRequest{OrderId: "order-1"})
+			if tt.wantErr {
+				wantCode(t, err, fiber.StatusConflict)
+			} else if err != nil || res.PaymentLink != "http://pay/1" {
+				t.Errorf("SendOrder = %v, %v, want the shared link", res, err)
+			}
+			if payments.cancels != tt.wantCancels {
+				t.Errorf("CancelPayment calls = %d, want %d", payments.cancels, tt.wantCancels)
+			}
+			if len(payments.created) != 1 || payments.created[0].IdempotencyKey != "send-order-1" {
+				t.Errorf("payment requests = %v", payments.created)
+			}
+		})
+	}
+}
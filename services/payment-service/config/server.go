@@ -0,0 +1,53 @@
+package config
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/daffaromero/retries/services/common/utils"
+)
+
+var (
+	PaymentProcessor = utils.GetEnv("PAYMENT_PROCESSOR")
+	WebhookSecret    = utils.GetEnv("STRIPE_WEBHOOK_SECRET")
+	// AdminToken guards the operator endpoints, such as webhook replay. They
+	// are not served when it is empty.
+	AdminToken = utils.GetEnv("PAYMENT_ADMIN_TOKEN")
+	Currency   = currency()
+)
+
+// currency is the ISO code payments are charged in when a request does not
+// name one.
+func currency() string {
+	if c := utils.GetEnv("PAYMENT_CURRENCY"); c != "" {
+		return c
+	}
+	return "usd"
+}
+
+type ServerConfig struct {
+	URI      string
+	Port     string
+	Host     string
+	GRPCPort string
+	GRPCHost string
+}
+
+func NewServerConfig() ServerConfig {
+	uri := utils.GetEnv("SERVER_URI")
+	port := utils.GetEnv("SERVER_PORT")
+	if port == "" {
+		log.Fatal("SERVER_PORT environment variable is not set")
+	}
+	grpcPort := utils.GetEnv("GRPC_PORT")
+	if grpcPort == "" {
+		log.Fatal("GRPC_PORT environment variable is not set")
+	}
+	return ServerConfig{
+		URI:      uri,
+		Port:     port,
+		Host:     fmt.Sprintf("%s:%s", uri, port),
+		GRPCPort: grpcPort,
+		GRPCHost: fmt.Sprintf("%s:%s", uri, grpcPort),
+	}
+}
@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"errors"
+
+	"github.com/daffaromero/retries/services/payment-service/processor"
+	"github.com/daffaromero/retries/services/payment-service/service"
+	"github.com/gofiber/fiber/v3"
+)
+
+// FakeCheckoutController plays the customer on the fake processor's checkout
+// pages. It moves a session on and delivers the signed event Stripe would
+// send through the webhook service, so the whole settlement flow can be run
+// locally. Only route it when the fake processor is in use.
+type FakeCheckoutController interface {
+	Route(*fiber.App)
+	Finish(fiber.Ctx) error
+}
+
+type fakeCheckoutController struct {
+	fake           *processor.Fake
+	secret         string
+	webhookService service.WebhookService
+}
+
+func NewFakeCheckoutController(fake *processor.Fake, secret string, whServ service.WebhookService) FakeCheckoutController {
+	return &fakeCheckoutController{fake: fake, secret: secret, webhookService: whServ}
+}
+
+func (f *fakeCheckoutController) Route(app *fiber.App) {
+	app.Post("/fake/checkout/:id/:outcome", f.Finish)
+}
+
+// Finish completes, fails or expires the session named in the path.
+func (f *fakeCheckoutController) Finish(c fiber.Ctx) error {
+	var transition func(string) ([]byte, error)
+	switch c.Params("outcome") {
+	case "complete":
+		transition = f.fake.Complete
+	case "fail":
+		transition = f.fake.Fail
+	case "expire":
+		transition = f.fake.Expire
+	default:
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": "outcome must be complete, fail or expire"})
+	}
+
+	payload, err := transition(c.Params("id"))
+	switch {
+	case errors.Is(err, processor.ErrSessionNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, processor.ErrInvalidTransition):
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{"error": err.Error()})
+	case err != nil:
+		return errorResponse(c, err)
+	}
+
+	if err := f.webhookService.Ingest(c.Context(), payload, processor.SignEvent(payload, f.secret)); err != nil {
+		return errorResponse(c, err)
+	}
+	return c.SendStatus(fiber.StatusOK)
+}
@@ -0,0 +1,106 @@
+package controller
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/daffaromero/retries/services/common/genproto/payment"
+	"github.com/daffaromero/retries/services/payment-service/processor"
+	"github.com/daffaromero/retries/services/payment-service/service"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type paymentGRPCServer struct {
+	pb.UnimplementedPaymentServiceServer
+	paymentService service.PaymentService
+}
+
+func NewPaymentGRPCServer(payServ service.PaymentService) pb.PaymentServiceServer {
+	return &paymentGRPCServer{paymentService: payServ}
+}
+
+func (p *paymentGRPCServer) CreatePayment(ctx context.Context, req *pb.CreatePaymentRequest) (*pb.CreatePaymentResponse, error) {
+	if req.OrderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "order_id not provided")
+	}
+	if req.Amount <= 0 && len(req.LineItems) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+	for _, it := range req.LineItems {
+		if it.UnitAmount < 0 || it.Quantity <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "line items need a quantity and a non-negative amount")
+		}
+	}
+	pay, err := p.paymentService.CreatePayment(ctx, req)
+	if err != nil {
+		return nil, paymentStatus(err, req.OrderId, "failed to create payment")
+	}
+	return &pb.CreatePaymentResponse{
+		Id:          pay.Id,
+		Status:      true,
+		PaymentLink: pay.PaymentLink,
+	}, nil
+}
+
+func (p *paymentGRPCServer) GetPayment(ctx context.Context, req *pb.GetPaymentRequest) (*pb.GetPaymentResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id not provided")
+	}
+	pay, err := p.paymentService.GetPayment(ctx, req)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "no payment found with ID %s", req.Id)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get payment")
+	}
+	return &pb.GetPaymentResponse{Payment: pay}, nil
+}
+
+func (p *paymentGRPCServer) ListPayments(ctx context.Context, req *pb.ListPaymentsRequest) (*pb.ListPaymentsResponse, error) {
+	if req.CustomerId == "" {
+		return nil, status.Error(codes.InvalidArgument, "customer_id not provided")
+	}
+	res, err := p.paymentService.ListPayments(ctx, req)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list payments")
+	}
+	return res, nil
+}
+
+func (p *paymentGRPCServer) CancelPayment(ctx context.Context, req *pb.CancelPaymentRequest) (*pb.Payment, error) {
+	if req.OrderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "order_id not provided")
+	}
+	pay, err := p.paymentService.CancelPayment(ctx, req)
+	if err != nil {
+		return nil, paymentStatus(err, req.OrderId, "failed to cancel payment")
+	}
+	return pay, nil
+}
+
+func (p *paymentGRPCServer) RefundPayment(ctx context.Context, req *pb.RefundPaymentRequest) (*pb.RefundPaymentResponse, error) {
+	if req.OrderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "order_id not provided")
+	}
+	if req.Amount < 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must not be negative")
+	}
+	res, err := p.paymentService.RefundPayment(ctx, req)
+	if err != nil {
+		return nil, paymentStatus(err, req.OrderId, "failed to refund payment")
+	}
+	return res, nil
+}
+
+// refundStatus maps errors from cancelling or refunding an order's payment.
+func paymentStatus(err error, orderID, msg string) error {
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return status.Errorf(codes.NotFound, "no matching payment found for order %s", orderID)
+	case errors.Is(err, processor.ErrInvalidTransition), errors.Is(err, processor.ErrSessionNotFound), errors.Is(err, processor.ErrRefundTooLarge):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, msg)
+	}
+}
@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"crypto/subtle"
+	"errors"
+
+	"github.com/daffaromero/retries/services/payment-service/service"
+	"github.com/gofiber/fiber/v3"
+)
+
+type WebhookController interface {
+	Route(*fiber.App)
+	HandleStripe(fiber.Ctx) error
+	ReplayEvent(fiber.Ctx) error
+}
+
+type webhookController struct {
+	webhookService service.WebhookService
+	adminToken     string
+}
+
+// NewWebhookController serves Stripe's webhook. Replaying stored events is
+// an operator action: it needs adminToken as a bearer token and is not routed
+// at all when adminToken is empty.
+func NewWebhookController(whServ service.WebhookService, adminToken string) WebhookController {
+	return &webhookController{webhookService: whServ, adminToken: adminToken}
+}
+
+func (w *webhookController) Route(app *fiber.App) {
+	api := app.Group("/webhooks")
+	api.Post("/stripe", w.HandleStripe)
+	if w.adminToken != "" {
+		api.Post("/stripe/events/:id/replay", w.ReplayEvent, w.requireAdmin)
+	}
+}
+
+func (w *webhookController) requireAdmin(c fiber.Ctx) error {
+	got := []byte(c.Get(fiber.HeaderAuthorization))
+	want := []byte("Bearer " + w.adminToken)
+	if subtle.ConstantTimeCompare(got, want) != 1 {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{"error": "admin token required"})
+	}
+	return c.Next()
+}
+
+func (w *webhookController) HandleStripe(c fiber.Ctx) error {
+	err := w.webhookService.Ingest(c.Context(), c.Body(), c.Get("Stripe-Signature"))
+	if err != nil {
+		return errorResponse(c, err)
+	}
+	return c.SendStatus(fiber.StatusOK)
+}
+
+func (w *webhookController) ReplayEvent(c fiber.Ctx) error {
+	id := c.Params("id")
+	if id == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "event id not provided"})
+	}
+	if err := w.webhookService.Replay(c.Context(), id); err != nil {
+		return errorResponse(c, err)
+	}
+	return c.SendStatus(fiber.StatusOK)
+}
+
+// errorResponse answers with the fiber error's status. Anything else is a
+// 500, which makes Stripe retry the delivery.
+func errorResponse(c fiber.Ctx, err error) error {
+	var fe *fiber.Error
+	if errors.As(err, &fe) {
+		return c.Status(fe.Code).JSON(fiber.Map{"error": fe.Message})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"net"
+	"time"
+
+	"github.com/daffaromero/retries/services/common/discovery"
+	"github.com/daffaromero/retries/services/common/discovery/backend"
+	pb "github.com/daffaromero/retries/services/common/genproto/payment"
+	"github.com/daffaromero/retries/services/common/health"
+	"github.com/daffaromero/retries/services/common/migrate"
+	"github.com/daffaromero/retries/services/common/shutdown"
+	"github.com/daffaromero/retries/services/common/store"
+	"github.com/daffaromero/retries/services/common/telemetry"
+	"github.com/daffaromero/retries/services/common/utils/logger"
+	"github.com/daffaromero/retries/services/payment-service/config"
+	"github.com/daffaromero/retries/services/payment-service/controller"
+	"github.com/daffaromero/retries/services/payment-service/migrations"
+	"github.com/daffaromero/retries/services/payment-service/processor"
+	"github.com/daffaromero/retries/services/payment-service/repository"
+	"github.com/daffaromero/retries/services/payment-service/repository/query"
+	"github.com/daffaromero/retries/services/payment-service/service"
+	"github.com/gofiber/fiber/v3"
+	"github.com/gofiber/fiber/v3/middleware/adaptor"
+	flog "github.com/gofiber/fiber/v3/middleware/logger"
+	"github.com/gofiber/fiber/v3/middleware/requestid"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+var logs = logger.NewLog("main")
+
+var migrateOnStart = flag.Bool("migrate", false, "apply pending schema migrations before serving")
+
+func webServer(whCont controller.WebhookController, fakeCont controller.FakeCheckoutController, checker *health.Checker, metrics *telemetry.Metrics) *fiber.App {
+	app := fiber.New()
+
+	app.Use(requestid.New())
+	app.Use(flog.New())
+
+	whCont.Route(app)
+	if fakeCont != nil {
+		fakeCont.Route(app)
+	}
+	app.Get("/healthz", adaptor.HTTPHandlerFunc(checker.LiveHandler()))
+	app.Get("/readyz", adaptor.HTTPHandlerFunc(checker.ReadyHandler()))
+	app.Get("/metrics", adaptor.HTTPHandler(metrics.Handler()))
+
+	return app
+}
+
+func grpcServer(payServ service.PaymentService, checker *health.Checker) *grpc.Server {
+	srv := grpc.NewServer(grpc.StatsHandler(otelgrpc.NewServerHandler()))
+	pb.RegisterPaymentServiceServer(srv, controller.NewPaymentGRPCServer(payServ))
+	healthpb.RegisterHealthServer(srv, checker.GRPC())
+
+	return srv
+}
+
+func main() {
+	flag.Parse()
+	ctx := context.Background()
+
+	serverConfig := config.NewServerConfig()
+	shutdownTimeout, err := shutdown.TimeoutFromEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	metrics, err := telemetry.NewMetrics("payment-service")
+	if err != nil {
+		log.Fatal(err)
+	}
+	dbConfig := config.NewPGDatabase()
+
+	migrator, err := migrate.New(dbConfig, "payment-service", migrations.FS)
+	if err != nil {
+		log.Fatalf("failed to load migrations: %v", err)
+	}
+	if flag.Arg(0) == "migrate" {
+		if err := migrator.Run(ctx, flag.Args()[1:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if *migrateOnStart {
+		if err := migrator.Up(ctx); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := migrator.Check(ctx); err != nil {
+		log.Fatal(err)
+	}
+
+	dbStore := store.New(dbConfig, time.Duration(config.TimeOutDuration)*time.Second)
+
+	registry, err := backend.NewFromEnv()
+	if err != nil {
+		log.Fatalf("failed to create service registry: %v", err)
+	}
+
+	payQuery := query.NewPaymentQueryImpl(dbConfig)
+	payRepo := repository.NewPaymentRepository(dbStore, payQuery)
+	proc, err := processor.New(config.PaymentProcessor)
+	if err != nil {
+		log.Fatalf("failed to create payment processor: %v", err)
+	}
+	payServ := service.NewPaymentService(payRepo, proc, config.Currency, logs)
+
+	eventQuery := query.NewEventQueryImpl(dbConfig)
+	eventRepo := repository.NewEventRepository(dbStore, eventQuery)
+	whServ := service.NewWebhookService(config.WebhookSecret, registry, payRepo, eventRepo, logs)
+	whCont := controller.NewWebhookController(whServ, config.AdminToken)
+	var fakeCont controller.FakeCheckoutController
+	if fake, ok := proc.(*processor.Fake); ok {
+		fakeCont = controller.NewFakeCheckoutController(fake, config.WebhookSecret, whServ)
+	}
+
+	reg, err := discovery.Register(ctx, registry, discovery.PaymentServiceName, serverConfig.GRPCHost)
+	if err != nil {
+		log.Fatalf("failed to register payment service: %v", err)
+	}
+
+	checker := health.NewChecker(discovery.PaymentServiceName)
+	checker.Add("postgres", dbConfig.Ping)
+	// The order-service client is left out: order-service depends on this
+	// service, so gating on it would let each take the other out of rotation.
+	checker.Track(reg)
+	go checker.Run(ctx)
+
+	app := webServer(whCont, fakeCont, checker, metrics)
+	srv := grpcServer(payServ, checker)
+
+	lis, err := net.Listen("tcp", serverConfig.GRPCHost)
+	if err != nil {
+		log.Fatalf("failed to listen on %s: %v", serverConfig.GRPCHost, err)
+	}
+	go func() {
+		log.Printf("gRPC server listening on %s", serverConfig.GRPCHost)
+		if err := srv.Serve(lis); err != nil {
+			log.Fatalf("grpcServer failed: %v", err)
+		}
+	}()
+
+	go func() {
+		if err := app.Listen(serverConfig.Host); err != nil {
+			log.Fatalf("webServer failed: %v", err)
+		}
+	}()
+
+	stop := shutdown.New(shutdownTimeout)
+	stop.Add("mark not ready", checker.Shutdown)
+	stop.Add("deregister", func(context.Context) error { return reg.Close() })
+	stop.Add("drain servers", shutdown.All(app.ShutdownWithContext, shutdown.GRPCServer(srv)))
+	stop.Add("close database", func(context.Context) error {
+		dbConfig.Close()
+		return nil
+	})
+	stop.Add("stop metrics", metrics.Shutdown)
+	stop.Add("flush logs", func(context.Context) error { return logs.Sync() })
+	stop.Wait()
+}
@@ -0,0 +1,5 @@
+DROP INDEX payments_order_id_idx;
+DROP INDEX payments_customer_id_idx;
+ALTER TABLE payments DROP COLUMN updated_at;
+ALTER TABLE payments DROP COLUMN payment_link;
+ALTER TABLE payments RENAME TO payment;
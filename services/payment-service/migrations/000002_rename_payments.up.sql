@@ -0,0 +1,5 @@
+ALTER TABLE payment RENAME TO payments;
+ALTER TABLE payments ADD COLUMN payment_link VARCHAR;
+ALTER TABLE payments ADD COLUMN updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP;
+CREATE INDEX payments_customer_id_idx ON payments (customer_id);
+CREATE INDEX payments_order_id_idx ON payments (order_id);
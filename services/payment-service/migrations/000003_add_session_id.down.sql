@@ -0,0 +1,2 @@
+DROP INDEX payments_session_id_idx;
+ALTER TABLE payments DROP COLUMN session_id;
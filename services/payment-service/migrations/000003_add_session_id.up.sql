@@ -0,0 +1,2 @@
+ALTER TABLE payments ADD COLUMN session_id VARCHAR;
+CREATE UNIQUE INDEX payments_session_id_idx ON payments (session_id);
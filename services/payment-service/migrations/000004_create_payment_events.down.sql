@@ -0,0 +1,2 @@
+DROP INDEX payment_events_type_idx;
+DROP TABLE payment_events;
@@ -0,0 +1,9 @@
+CREATE TABLE payment_events (
+    id VARCHAR PRIMARY KEY,
+    type VARCHAR NOT NULL,
+    payload JSONB NOT NULL,
+    received_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    processed_at TIMESTAMP,
+    error VARCHAR
+);
+CREATE INDEX payment_events_type_idx ON payment_events (type);
@@ -0,0 +1 @@
+ALTER TABLE payments ADD COLUMN refunded_amount DECIMAL(10, 2) NOT NULL DEFAULT 0;
@@ -0,0 +1,9 @@
+CREATE TABLE refunds (
+    payment_id VARCHAR(36) NOT NULL REFERENCES payments (id),
+    idempotency_key VARCHAR NOT NULL,
+    refund_id VARCHAR,
+    amount DECIMAL(10, 2) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (payment_id, idempotency_key)
+);
@@ -0,0 +1,2 @@
+DROP INDEX payments_idempotency_key_idx;
+ALTER TABLE payments DROP COLUMN idempotency_key;
@@ -0,0 +1,2 @@
+ALTER TABLE payments ADD COLUMN idempotency_key VARCHAR;
+CREATE UNIQUE INDEX payments_idempotency_key_idx ON payments (idempotency_key) WHERE status = 'pending';
@@ -0,0 +1,8 @@
+// Package migrations embeds the payment-service schema migrations, applied with
+// the migrate package.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
@@ -0,0 +1,212 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stripe/stripe-go/v79"
+	"github.com/stripe/stripe-go/v79/webhook"
+)
+
+// Fake is an in-memory Processor for tests and local development. Sessions
+// only change state when one of Complete, Fail or Expire is called, so every
+// flow is reproducible without network access. Session and event IDs are
+// random, so they do not clash with the ones a previous run left in the
+// database. Those calls return the webhook event Stripe would send,
+// ready to be signed with SignEvent and fed to the webhook endpoint.
+type Fake struct {
+	sync.Mutex
+	baseURL  string
+	sessions map[string]*fakeSession
+}
+
+type fakeSession struct {
+	orderID  string
+	amount   int64
+	refunded int64
+	status   Status
+	refunds  map[string]*Refund
+}
+
+var _ Processor = (*Fake)(nil)
+
+func NewFake(baseURL string) *Fake {
+	return &Fake{
+		baseURL:  baseURL,
+		sessions: map[string]*fakeSession{},
+	}
+}
+
+func (f *Fake) CreatePaymentLink(ctx context.Context, req *LinkRequest) (*Link, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	id := "cs_fake_" + uuid.NewString()
+	f.sessions[id] = &fakeSession{
+		orderID: req.OrderID,
+		amount:  req.Total(),
+		status:  StatusPending,
+		refunds: map[string]*Refund{},
+	}
+	return &Link{
+		SessionID: id,
+		URL:       fmt.Sprintf("%s/checkout/%s", f.baseURL, id),
+	}, nil
+}
+
+func (f *Fake) GetPaymentStatus(ctx context.Context, sessionID string) (Status, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	s, ok := f.sessions[sessionID]
+	if !ok {
+		return "", ErrSessionNotFound
+	}
+	return s.status, nil
+}
+
+func (f *Fake) Refund(ctx context.Context, sessionID string, amount int64, idempotencyKey string) (*Refund, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	s, ok := f.sessions[sessionID]
+	if !ok {
+		return nil, ErrSessionNotFound
+	}
+	if ref, ok := s.refunds[idempotencyKey]; ok && idempotencyKey != "" {
+		return ref, nil
+	}
+	if s.status != StatusPaid {
+		return nil, ErrInvalidTransition
+	}
+
+	remaining := s.amount - s.refunded
+	if amount == 0 {
+		amount = remaining
+	}
+	if amount < 0 || amount > remaining {
+		return nil, ErrRefundTooLarge
+	}
+	s.refunded += amount
+	if s.refunded == s.amount {
+		s.status = StatusRefunded
+	}
+	ref := &Refund{
+		ID:     fmt.Sprintf("re_fake_%s_%d", sessionID, s.refunded),
+		Amount: amount,
+	}
+	if idempotencyKey != "" {
+		s.refunds[idempotencyKey] = ref
+	}
+	return ref, nil
+}
+
+func (f *Fake) Cancel(ctx context.Context, sessionID string) error {
+	f.Lock()
+	defer f.Unlock()
+
+	if s, ok := f.sessions[sessionID]; ok && (s.status == StatusExpired || s.status == StatusCanceled) {
+		return nil
+	}
+	_, err := f.transition(sessionID, StatusCanceled)
+	return err
+}
+
+// Complete marks a pending session as paid, as if the customer had finished
+// checkout, and returns the checkout.session.completed event Stripe would
+// send for it.
+func (f *Fake) Complete(sessionID string) ([]byte, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	s, err := f.transition(sessionID, StatusPaid)
+	if err != nil {
+		return nil, err
+	}
+	return f.event(stripe.EventTypeCheckoutSessionCompleted, map[string]any{
+		"id":             sessionID,
+		"object":         "checkout.session",
+		"status":         "complete",
+		"payment_status": "paid",
+		"metadata":       map[string]string{"order_id": s.orderID},
+	})
+}
+
+// Fail declines a charge on a pending session and returns the
+// payment_intent.payment_failed event Stripe would send for it. Like Stripe,
+// it leaves the session open so the customer can try again.
+func (f *Fake) Fail(sessionID string) ([]byte, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	s, ok := f.sessions[sessionID]
+	if !ok {
+		return nil, ErrSessionNotFound
+	}
+	if s.status != StatusPending {
+		return nil, ErrInvalidTransition
+	}
+	return f.event(stripe.EventTypePaymentIntentPaymentFailed, map[string]any{
+		"id":       "pi_" + sessionID,
+		"object":   "payment_intent",
+		"status":   "requires_payment_method",
+		"metadata": map[string]string{"order_id": s.orderID},
+	})
+}
+
+// Expire marks a pending session as expired and returns the
+// checkout.session.expired event Stripe would send for it.
+func (f *Fake) Expire(sessionID string) ([]byte, error) {
+	f.Lock()
+	defer f.Unlock()
+
+	s, err := f.transition(sessionID, StatusExpired)
+	if err != nil {
+		return nil, err
+	}
+	return f.event(stripe.EventTypeCheckoutSessionExpired, map[string]any{
+		"id":             sessionID,
+		"object":         "checkout.session",
+		"status":         "expired",
+		"payment_status": "unpaid",
+		"metadata":       map[string]string{"order_id": s.orderID},
+	})
+}
+
+// SignEvent returns the Stripe-Signature header Stripe would send with
+// payload under the webhook signing secret.
+func SignEvent(payload []byte, secret string) string {
+	return webhook.GenerateTestSignedPayload(&webhook.UnsignedPayload{
+		Payload: payload,
+		Secret:  secret,
+	}).Header
+}
+
+// transition moves a pending session to status. The caller holds the lock.
+func (f *Fake) transition(sessionID string, to Status) (*fakeSession, error) {
+	s, ok := f.sessions[sessionID]
+	if !ok {
+		return nil, ErrSessionNotFound
+	}
+	if s.status != StatusPending {
+		return nil, ErrInvalidTransition
+	}
+	s.status = to
+	return s, nil
+}
+
+// event encodes a webhook event wrapping object. The caller holds the lock.
+func (f *Fake) event(typ stripe.EventType, object map[string]any) ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"id":          "evt_fake_" + uuid.NewString(),
+		"object":      "event",
+		"api_version": stripe.APIVersion,
+		"created":     time.Now().Unix(),
+		"type":        typ,
+		"data":        map[string]any{"object": object},
+	})
+}
@@ -0,0 +1,290 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stripe/stripe-go/v79"
+	"github.com/stripe/stripe-go/v79/webhook"
+)
+
+const testSecret = "whsec_test"
+
+func newSession(t *testing.T, f *Fake, amount int64) string {
+	t.Helper()
+	link, err := f.CreatePaymentLink(context.Background(), &LinkRequest{OrderID: "order-1", Amount: amount})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return link.SessionID
+}
+
+func wantStatus(t *testing.T, f *Fake, id string, want Status) {
+	t.Helper()
+	got, err := f.GetPaymentStatus(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("status = %s, want %s", got, want)
+	}
+}
+
+func TestFakeCreatePaymentLink(t *testing.T) {
+	f := NewFake("http://gateway")
+	link, err := f.CreatePaymentLink(context.Background(), &LinkRequest{
+		OrderID: "order-1",
+		Items:   []LineItem{{Name: "a", UnitAmount: 250, Quantity: 2}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(link.SessionID, "cs_fake_") || link.URL != "http://gateway/checkout/"+link.SessionID {
+		t.Errorf("link = %+v", link)
+	}
+	// A restarted fake must not hand out IDs a previous one did.
+	if other := newSession(t, NewFake(""), 500); other == link.SessionID {
+		t.Errorf("two fakes both handed out %s", other)
+	}
+	wantStatus(t, f, link.SessionID, StatusPending)
+
+	if _, err := f.GetPaymentStatus(context.Background(), "cs_missing"); !errors.Is(err, ErrSessionNotFound) {
+		t.Errorf("GetPaymentStatus(missing) = %v, want ErrSessionNotFound", err)
+	}
+}
+
+func TestFakeTransitions(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(f *Fake, id string) error
+		want Status
+	}{
+		{
+			name: "complete",
+			run:  func(f *Fake, id string) error { _, err := f.Complete(id); return err },
+			want: StatusPaid,
+		},
+		{
+			name: "fail keeps the session open",
+			run:  func(f *Fake, id string) error { _, err := f.Fail(id); return err },
+			want: StatusPending,
+		},
+		{
+			name: "expire",
+			run:  func(f *Fake, id string) error { _, err := f.Expire(id); return err },
+			want: StatusExpired,
+		},
+		{
+			name: "cancel",
+			run:  func(f *Fake, id string) error { return f.Cancel(context.Background(), id) },
+			want: StatusCanceled,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFake("")
+			id := newSession(t, f, 1000)
+			if err := tt.run(f, id); err != nil {
+				t.Fatal(err)
+			}
+			wantStatus(t, f, id, tt.want)
+
+			if err := tt.run(f, "cs_missing"); !errors.Is(err, ErrSessionNotFound) {
+				t.Errorf("on a missing session: %v, want ErrSessionNotFound", err)
+			}
+		})
+	}
+}
+
+func TestFakeTransitionsNeedPendingSession(t *testing.T) {
+	f := NewFake("")
+	id := newSession(t, f, 1000)
+	if _, err := f.Complete(id); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := f.Complete(id); !errors.Is(err, ErrInvalidTransition) {
+		t.Errorf("Complete twice = %v, want ErrInvalidTransition", err)
+	}
+	if _, err := f.Fail(id); !errors.Is(err, ErrInvalidTransition) {
+		t.Errorf("Fail after Complete = %v, want ErrInvalidTransition", err)
+	}
+	if _, err := f.Expire(id); !errors.Is(err, ErrInvalidTransition) {
+		t.Errorf("Expire after Complete = %v, want ErrInvalidTransition", err)
+	}
+	if err := f.Cancel(context.Background(), id); !errors.Is(err, ErrInvalidTransition) {
+		t.Errorf("Cancel after Complete = %v, want ErrInvalidTransition", err)
+	}
+	wantStatus(t, f, id, StatusPaid)
+}
+
+func TestFakeCancelClosedSession(t *testing.T) {
+	f := NewFake("")
+	expired := newSession(t, f, 1000)
+	if _, err := f.Expire(expired); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Cancel(context.Background(), expired); err != nil {
+		t.Errorf("Cancel after Expire = %v, want nil", err)
+	}
+	wantStatus(t, f, expired, StatusExpired)
+
+	canceled := newSession(t, f, 1000)
+	for i := 0; i < 2; i++ {
+		if err := f.Cancel(context.Background(), canceled); err != nil {
+			t.Errorf("Cancel %d = %v, want nil", i+1, err)
+		}
+	}
+	wantStatus(t, f, canceled, StatusCanceled)
+}
+
+func TestFakeEvents(t *testing.T) {
+	f := NewFake("")
+	paid := newSession(t, f, 1000)
+	expired := newSession(t, f, 1000)
+
+	tests := []struct {
+		name      string
+		event     func() ([]byte, error)
+		eventType stripe.EventType
+		objectID  string
+	}{
+		{"completed", func() ([]byte, error) { return f.Complete(paid) }, stripe.EventTypeCheckoutSessionCompleted, paid},
+		{"expired", func() ([]byte, error) { return f.Expire(expired) }, stripe.EventTypeCheckoutSessionExpired, expired},
+	}
+	seen := map[string]bool{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload, err := tt.event()
+			if err != nil {
+				t.Fatal(err)
+			}
+			event, err := webhook.ConstructEvent(payload, SignEvent(payload, testSecret), testSecret)
+			if err != nil {
+				t.Fatalf("signed event does not verify: %v", err)
+			}
+			if event.Type != tt.eventType {
+				t.Errorf("type = %s, want %s", event.Type, tt.eventType)
+			}
+			if seen[event.ID] {
+				t.Errorf("event ID %s reused", event.ID)
+			}
+			seen[event.ID] = true
+
+			var sess stripe.CheckoutSession
+			if err := json.Unmarshal(event.Data.Raw, &sess); err != nil {
+				t.Fatal(err)
+			}
+			if sess.ID != tt.objectID || sess.Metadata["order_id"] != "order-1" {
+				t.Errorf("session = %s with order %q, want %s with order-1", sess.ID, sess.Metadata["order_id"], tt.objectID)
+			}
+		})
+	}
+
+	if _, err := webhook.ConstructEvent([]byte(`{"id":"evt_1"}`), SignEvent([]byte(`{"id":"evt_1"}`), "whsec_other"), testSecret); err == nil {
+		t.Error("event signed with another secret verified")
+	}
+}
+
+func TestFakeFailEvent(t *testing.T) {
+	f := NewFake("")
+	id := newSession(t, f, 1000)
+	payload, err := f.Fail(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	event, err := webhook.ConstructEvent(payload, SignEvent(payload, testSecret), testSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var intent stripe.PaymentIntent
+	if err := json.Unmarshal(event.Data.Raw, &intent); err != nil {
+		t.Fatal(err)
+	}
+	if event.Type != stripe.EventTypePaymentIntentPaymentFailed || intent.Metadata["order_id"] != "order-1" {
+		t.Errorf("event = %s for order %q", event.Type, intent.Metadata["order_id"])
+	}
+
+	// The customer retries on the same link and pays.
+	if _, err := f.Complete(id); err != nil {
+		t.Errorf("Complete after Fail = %v", err)
+	}
+}
+
+func TestFakeRefund(t *testing.T) {
+	ctx := context.Background()
+	f := NewFake("")
+	id := newSession(t, f, 1000)
+
+	if _, err := f.Refund(ctx, id, 100, ""); !errors.Is(err, ErrInvalidTransition) {
+		t.Errorf("Refund before payment = %v, want ErrInvalidTransition", err)
+	}
+	if _, err := f.Complete(id); err != nil {
+		t.Fatal(err)
+	}
+
+	ref, err := f.Refund(ctx, id, 300, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ref.Amount != 300 {
+		t.Errorf("partial refund = %d, want 300", ref.Amount)
+	}
+	wantStatus(t, f, id, StatusPaid)
+
+	for _, amount := range []int64{701, -1} {
+		if _, err := f.Refund(ctx, id, amount, ""); !errors.Is(err, ErrRefundTooLarge) {
+			t.Errorf("Refund(%d) = %v, want ErrRefundTooLarge", amount, err)
+		}
+	}
+	wantStatus(t, f, id, StatusPaid)
+
+	ref, err = f.Refund(ctx, id, 0, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ref.Amount != 700 {
+		t.Errorf("refund of the rest = %d, want 700", ref.Amount)
+	}
+	wantStatus(t, f, id, StatusRefunded)
+
+	if _, err := f.Refund(ctx, id, 1, ""); !errors.Is(err, ErrInvalidTransition) {
+		t.Errorf("Refund after full refund = %v, want ErrInvalidTransition", err)
+	}
+	if _, err := f.Refund(ctx, "cs_missing", 1, ""); !errors.Is(err, ErrSessionNotFound) {
+		t.Errorf("Refund(missing) = %v, want ErrSessionNotFound", err)
+	}
+}
+
+func TestFakeRefundIdempotencyKey(t *testing.T) {
+	ctx := context.Background()
+	f := NewFake("")
+	id := newSession(t, f, 1000)
+	if _, err := f.Complete(id); err != nil {
+		t.Fatal(err)
+	}
+
+	first, err := f.Refund(ctx, id, 400, "refund-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := f.Refund(ctx, id, 400, "refund-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *again != *first {
+		t.Errorf("repeated refund = %+v, want %+v", again, first)
+	}
+
+	// Only the first refund took money: 600 is left.
+	if _, err := f.Refund(ctx, id, 601, "refund-2"); !errors.Is(err, ErrRefundTooLarge) {
+		t.Errorf("Refund(601) = %v, want ErrRefundTooLarge", err)
+	}
+	if _, err := f.Refund(ctx, id, 600, "refund-2"); err != nil {
+		t.Fatal(err)
+	}
+	wantStatus(t, f, id, StatusRefunded)
+}
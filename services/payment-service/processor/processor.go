@@ -0,0 +1,97 @@
+package processor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusPaid     Status = "paid"
+	StatusFailed   Status = "failed"
+	StatusExpired  Status = "expired"
+	StatusCanceled Status = "canceled"
+	StatusRefunded Status = "refunded"
+)
+
+var (
+	ErrSessionNotFound   = errors.New("payment session not found")
+	ErrInvalidTransition = errors.New("payment session is not in a state that allows this operation")
+	ErrRefundTooLarge    = errors.New("refund exceeds what is left of the payment")
+	// ErrInvalidRequest wraps the processor's reason for rejecting a request
+	// it considers malformed, such as an unsupported currency.
+	ErrInvalidRequest = errors.New("payment processor rejected the request")
+)
+
+// LinkRequest describes what a checkout link should charge for. Amounts are
+// in the currency's minor unit. When Items is empty the whole Amount is
+// charged as a single line.
+type LinkRequest struct {
+	OrderID       string
+	CustomerID    string
+	CustomerEmail string
+	Currency      string
+	Amount        int64
+	Items         []LineItem
+}
+
+type LineItem struct {
+	Name       string
+	UnitAmount int64
+	Quantity   int64
+}
+
+// Total is what the link charges: the sum of its items, or Amount when it has
+// none.
+func (r *LinkRequest) Total() int64 {
+	if len(r.Items) == 0 {
+		return r.Amount
+	}
+	var total int64
+	for _, it := range r.Items {
+		total += it.UnitAmount * it.Quantity
+	}
+	return total
+}
+
+type Link struct {
+	SessionID string
+	URL       string
+}
+
+type Refund struct {
+	ID     string
+	Amount int64
+}
+
+// Processor is a payment provider able to issue checkout links and settle
+// them afterwards.
+type Processor interface {
+	CreatePaymentLink(ctx context.Context, req *LinkRequest) (*Link, error)
+	GetPaymentStatus(ctx context.Context, sessionID string) (Status, error)
+	// Refund returns amount of a paid session to the customer; an amount of
+	// zero refunds whatever is left. Asking for more than is left fails with
+	// ErrRefundTooLarge. A refund repeated with the same non-empty
+	// idempotencyKey is made once and returned as before.
+	Refund(ctx context.Context, sessionID string, amount int64, idempotencyKey string) (*Refund, error)
+	// Cancel voids a session that has not been paid yet. A session that has
+	// already expired or been cancelled is left as it is; a paid one fails
+	// with ErrInvalidTransition.
+	Cancel(ctx context.Context, sessionID string) error
+}
+
+// New returns the processor registered under name. An empty name selects
+// Stripe.
+func New(name string) (Processor, error) {
+	switch name {
+	case "", "stripe":
+		return NewStripe(), nil
+	case "fake":
+		return NewFake(gatewayAddress), nil
+	default:
+		return nil, fmt.Errorf("unknown payment processor %q", name)
+	}
+}
@@ -1,38 +1,170 @@
 package processor
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
-	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
 	"github.com/daffaromero/retries/services/common/utils"
 	"github.com/stripe/stripe-go/v79"
 	"github.com/stripe/stripe-go/v79/checkout/session"
+	"github.com/stripe/stripe-go/v79/refund"
 )
 
-var gatewayAddress = utils.GetEnv("PAYMENT_GATEWAY_ADDR")
+var (
+	gatewayAddress = utils.GetEnv("PAYMENT_GATEWAY_ADDR")
+	secretKey      = utils.GetEnv("STRIPE_SECRET_KEY")
+)
 
 type Stripe struct{}
 
-func NewProcessor() *Stripe {
+var _ Processor = (*Stripe)(nil)
+
+func NewStripe() *Stripe {
+	stripe.Key = secretKey
 	return &Stripe{}
 }
 
-func (s *Stripe) CreatePaymentLink(o *pb.SendOrderRequest) (string, error) {
-	gatewaySuccessURL := fmt.Sprintf("%s/payment/success.html?&orderID=%s", gatewayAddress, o.OrderId)
+func (s *Stripe) CreatePaymentLink(ctx context.Context, req *LinkRequest) (*Link, error) {
+	gatewaySuccessURL := fmt.Sprintf("%s/payment/success.html?&orderID=%s", gatewayAddress, req.OrderID)
 	gatewayCancelURL := fmt.Sprintf("%s/payment/cancel.html", gatewayAddress)
 
+	items := req.Items
+	if len(items) == 0 {
+		items = []LineItem{{Name: "Order " + req.OrderID, UnitAmount: req.Amount, Quantity: 1}}
+	}
+	lineItems := make([]*stripe.CheckoutSessionLineItemParams, 0, len(items))
+	for _, it := range items {
+		lineItems = append(lineItems, &stripe.CheckoutSessionLineItemParams{
+			PriceData: &stripe.CheckoutSessionLineItemPriceDataParams{
+				Currency:   stripe.String(req.Currency),
+				UnitAmount: stripe.Int64(it.UnitAmount),
+				ProductData: &stripe.CheckoutSessionLineItemPriceDataProductDataParams{
+					Name: stripe.String(it.Name),
+				},
+			},
+			Quantity: stripe.Int64(it.Quantity),
+		})
+	}
+
 	params := &stripe.CheckoutSessionParams{
+		LineItems: lineItems,
 		Metadata: map[string]string{
-			"order_id": o.OrderId,
+			"order_id": req.OrderID,
+		},
+		// Payment intent events do not carry the session metadata.
+		PaymentIntentData: &stripe.CheckoutSessionPaymentIntentDataParams{
+			Metadata: map[string]string{
+				"order_id": req.OrderID,
+			},
 		},
 		Mode:       stripe.String(string(stripe.CheckoutSessionModePayment)),
 		SuccessURL: stripe.String(gatewaySuccessURL),
 		CancelURL:  stripe.String(gatewayCancelURL),
 	}
+	if req.CustomerEmail != "" {
+		params.CustomerEmail = stripe.String(req.CustomerEmail)
+	}
+	params.Context = ctx
 
 	res, err := session.New(params)
 	if err != nil {
-		return "", err
+		return nil, stripeError(err)
+	}
+	return &Link{SessionID: res.ID, URL: res.URL}, nil
+}
+
+func (s *Stripe) GetPaymentStatus(ctx context.Context, sessionID string) (Status, error) {
+	params := &stripe.CheckoutSessionParams{}
+	params.Context = ctx
+
+	res, err := session.Get(sessionID, params)
+	if err != nil {
+		return "", stripeError(err)
+	}
+
+	switch {
+	case res.PaymentStatus == stripe.CheckoutSessionPaymentStatusPaid:
+		return StatusPaid, nil
+	case res.Status == stripe.CheckoutSessionStatusExpired:
+		return StatusExpired, nil
+	default:
+		return StatusPending, nil
+	}
+}
+
+func (s *Stripe) Refund(ctx context.Context, sessionID string, amount int64, idempotencyKey string) (*Refund, error) {
+	params := &stripe.CheckoutSessionParams{}
+	params.Context = ctx
+	params.AddExpand("payment_intent")
+
+	sess, err := session.Get(sessionID, params)
+	if err != nil {
+		return nil, stripeError(err)
+	}
+	if sess.PaymentStatus != stripe.CheckoutSessionPaymentStatusPaid || sess.PaymentIntent == nil {
+		return nil, ErrInvalidTransition
+	}
+
+	refundParams := &stripe.RefundParams{PaymentIntent: stripe.String(sess.PaymentIntent.ID)}
+	refundParams.Context = ctx
+	if amount > 0 {
+		refundParams.Amount = stripe.Int64(amount)
+	}
+	if idempotencyKey != "" {
+		refundParams.SetIdempotencyKey(idempotencyKey)
+	}
+
+	res, err := refund.New(refundParams)
+	var stripeErr *stripe.Error
+	if errors.As(err, &stripeErr) && (stripeErr.Code == stripe.ErrorCodeAmountTooLarge || stripeErr.Code == stripe.ErrorCodeChargeAlreadyRefunded) {
+		return nil, fmt.Errorf("%w: %s", ErrRefundTooLarge, stripeErr.Msg)
+	}
+	if err != nil {
+		return nil, stripeError(err)
+	}
+	return &Refund{ID: res.ID, Amount: res.Amount}, nil
+}
+
+// Cancel expires the session. Stripe refuses to expire a session that is no
+// longer open, so on failure the session is looked up to tell one that has
+// already expired apart from one that was paid.
+func (s *Stripe) Cancel(ctx context.Context, sessionID string) error {
+	params := &stripe.CheckoutSessionExpireParams{}
+	params.Context = ctx
+
+	_, err := session.Expire(sessionID, params)
+	if err == nil {
+		return nil
+	}
+	getParams := &stripe.CheckoutSessionParams{}
+	getParams.Context = ctx
+	sess, getErr := session.Get(sessionID, getParams)
+	switch {
+	case getErr != nil:
+		return stripeError(err)
+	case sess.Status == stripe.CheckoutSessionStatusExpired:
+		return nil
+	case sess.Status == stripe.CheckoutSessionStatusComplete:
+		return ErrInvalidTransition
+	default:
+		return stripeError(err)
+	}
+}
+
+// stripeError maps the Stripe errors callers can act on to this package's
+// errors and passes the rest through.
+func stripeError(err error) error {
+	var stripeErr *stripe.Error
+	if !errors.As(err, &stripeErr) {
+		return err
+	}
+	switch {
+	case stripeErr.Code == stripe.ErrorCodeResourceMissing:
+		return fmt.Errorf("%w: %s", ErrSessionNotFound, stripeErr.Msg)
+	case stripeErr.Type == stripe.ErrorTypeInvalidRequest:
+		return fmt.Errorf("%w: %s", ErrInvalidRequest, stripeErr.Msg)
+	default:
+		return err
 	}
-	return res.URL, nil
 }
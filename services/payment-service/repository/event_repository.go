@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/daffaromero/retries/services/common/store"
+	"github.com/daffaromero/retries/services/payment-service/repository/query"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type EventRepository interface {
+	SaveEvent(context.Context, *query.PaymentEvent) error
+	GetEvent(c context.Context, id string) (*query.PaymentEvent, error)
+	MarkEventProcessed(c context.Context, id, errMsg string) error
+}
+
+type eventRepository struct {
+	db         store.Store
+	eventQuery query.EventQuery
+}
+
+func NewEventRepository(db store.Store, eventQuery query.EventQuery) EventRepository {
+	return &eventRepository{db: db, eventQuery: eventQuery}
+}
+
+func (e *eventRepository) SaveEvent(c context.Context, event *query.PaymentEvent) error {
+	return e.db.WithTx(c, func(tx pgx.Tx) error {
+		return e.eventQuery.SaveEvent(c, tx, event)
+	})
+}
+
+func (e *eventRepository) GetEvent(c context.Context, id string) (*query.PaymentEvent, error) {
+	var res *query.PaymentEvent
+	err := e.db.WithoutTx(c, func(pool *pgxpool.Pool) error {
+		event, err := e.eventQuery.GetEvent(c, id)
+		if err != nil {
+			return err
+		}
+		res = event
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (e *eventRepository) MarkEventProcessed(c context.Context, id, errMsg string) error {
+	return e.db.WithTx(c, func(tx pgx.Tx) error {
+		return e.eventQuery.MarkEventProcessed(c, tx, id, errMsg)
+	})
+}
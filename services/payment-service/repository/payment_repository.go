@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/daffaromero/retries/services/common/genproto/payment"
+	"github.com/daffaromero/retries/services/common/store"
+	"github.com/daffaromero/retries/services/payment-service/repository/query"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PaymentRepository interface {
+	CreatePayment(c context.Context, payment *pb.Payment, idempotencyKey string) (*pb.Payment, error)
+	GetPendingPayment(c context.Context, idempotencyKey string) (*pb.Payment, error)
+	GetPayment(context.Context, *pb.GetPaymentRequest) (*pb.Payment, error)
+	ListPayments(context.Context, *pb.ListPaymentsRequest) (*pb.ListPaymentsResponse, error)
+	UpdatePaymentStatus(c context.Context, id, status string) error
+	SettlePayment(c context.Context, sessionID, status string) (*pb.Payment, error)
+	ListOrderPayments(c context.Context, orderID, status string) ([]*pb.Payment, error)
+	ReserveRefund(c context.Context, orderID, key string, size func(*pb.Payment) (float64, error)) (*query.Refund, error)
+	CompleteRefund(c context.Context, refund *query.Refund) error
+	ReleaseRefund(c context.Context, refund *query.Refund) error
+}
+
+type paymentRepository struct {
+	db       store.Store
+	payQuery query.PaymentQuery
+}
+
+func NewPaymentRepository(db store.Store, payQuery query.PaymentQuery) PaymentRepository {
+	return &paymentRepository{db: db, payQuery: payQuery}
+}
+
+func (p *paymentRepository) CreatePayment(c context.Context, payment *pb.Payment, idempotencyKey string) (*pb.Payment, error) {
+	var res *pb.Payment
+	err := p.db.WithTx(c, func(tx pgx.Tx) error {
+		pay, err := p.payQuery.CreatePayment(c, tx, payment, idempotencyKey)
+		if err != nil {
+			return err
+		}
+		res = pay
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (p *paymentRepository) GetPayment(c context.Context, req *pb.GetPaymentRequest) (*pb.Payment, error) {
+	var res *pb.Payment
+	err := p.db.WithoutTx(c, func(pool *pgxpool.Pool) error {
+		pay, err := p.payQuery.GetPayment(c, req)
+		if err != nil {
+			return err
+		}
+		res = pay
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (p *paymentRepository) GetPendingPayment(c context.Context, idempotencyKey string) (*pb.Payment, error) {
+	var res *pb.Payment
+	err := p.db.WithoutTx(c, func(pool *pgxpool.Pool) error {
+		pay, err := p.payQuery.GetPendingPayment(c, idempotencyKey)
+		if err != nil {
+			return err
+		}
+		res = pay
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (p *paymentRepository) ListPayments(c context.Context, req *pb.ListPaymentsRequest) (*pb.ListPaymentsResponse, error) {
+	var res *pb.ListPaymentsResponse
+	err := p.db.WithoutTx(c, func(pool *pgxpool.Pool) error {
+		pays, err := p.payQuery.ListPayments(c, req)
+		if err != nil {
+			return err
+		}
+		res = pays
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (p *paymentRepository) UpdatePaymentStatus(c context.Context, id, status string) error {
+	return p.db.WithTx(c, func(tx pgx.Tx) error {
+		return p.payQuery.UpdatePaymentStatus(c, tx, id, status)
+	})
+}
+
+func (p *paymentRepository) SettlePayment(c context.Context, sessionID, status string) (*pb.Payment, error) {
+	var res *pb.Payment
+	err := p.db.WithTx(c, func(tx pgx.Tx) error {
+		pay, err := p.payQuery.SettlePayment(c, tx, sessionID, status)
+		if err != nil {
+			return err
+		}
+		res = pay
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (p *paymentRepository) ListOrderPayments(c context.Context, orderID, status string) ([]*pb.Payment, error) {
+	var res []*pb.Payment
+	err := p.db.WithoutTx(c, func(pool *pgxpool.Pool) error {
+		pays, err := p.payQuery.ListOrderPayments(c, orderID, status)
+		if err != nil {
+			return err
+		}
+		res = pays
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ReserveRefund returns the refund stored under key for the order's paid
+// payment. When there is none, the payment is handed to size, which returns
+// the amount to refund, and that amount is held back from the payment as a
+// pending refund. The payment stays locked throughout, so concurrent refunds
+// are sized one after another.
+func (p *paymentRepository) ReserveRefund(c context.Context, orderID, key string, size func(*pb.Payment) (float64, error)) (*query.Refund, error) {
+	var res *query.Refund
+	err := p.db.WithTx(c, func(tx pgx.Tx) error {
+		pay, err := p.payQuery.LockRefundablePayment(c, tx, orderID)
+		if err != nil {
+			return err
+		}
+		ref, err := p.payQuery.GetRefund(c, tx, pay.Id, key)
+		if err == nil {
+			res = ref
+			return nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+
+		amount, err := size(pay)
+		if err != nil {
+			return err
+		}
+		ref = &query.Refund{PaymentID: pay.Id, IdempotencyKey: key, Amount: amount, Status: query.RefundPending}
+		if err := p.payQuery.InsertRefund(c, tx, ref); err != nil {
+			return err
+		}
+		if _, err := p.payQuery.AddRefunded(c, tx, pay.Id, amount); err != nil {
+			return err
+		}
+		res = ref
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CompleteRefund records that the processor made a pending refund.
+func (p *paymentRepository) CompleteRefund(c context.Context, refund *query.Refund) error {
+	return p.db.WithTx(c, func(tx pgx.Tx) error {
+		return p.payQuery.CompleteRefund(c, tx, refund.PaymentID, refund.IdempotencyKey, refund.RefundID)
+	})
+}
+
+// ReleaseRefund drops a pending refund the processor did not make and gives
+// its amount back to the payment.
+func (p *paymentRepository) ReleaseRefund(c context.Context, refund *query.Refund) error {
+	return p.db.WithTx(c, func(tx pgx.Tx) error {
+		if err := p.payQuery.DeleteRefund(c, tx, refund.PaymentID, refund.IdempotencyKey); err != nil {
+			return err
+		}
+		_, err := p.payQuery.AddRefunded(c, tx, refund.PaymentID, -refund.Amount)
+		return err
+	})
+}
@@ -0,0 +1,63 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PaymentEvent is a webhook event as it was received from the processor. The
+// raw payload is kept so the event can be replayed later.
+type PaymentEvent struct {
+	ID          string
+	Type        string
+	Payload     []byte
+	ReceivedAt  time.Time
+	ProcessedAt *time.Time
+	Error       string
+}
+
+type EventQuery interface {
+	SaveEvent(c context.Context, tx pgx.Tx, event *PaymentEvent) error
+	GetEvent(c context.Context, id string) (*PaymentEvent, error)
+	MarkEventProcessed(c context.Context, tx pgx.Tx, id, errMsg string) error
+}
+
+type EventQueryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewEventQueryImpl(db *pgxpool.Pool) EventQuery {
+	return &EventQueryImpl{db: db}
+}
+
+func (e *EventQueryImpl) SaveEvent(c context.Context, tx pgx.Tx, event *PaymentEvent) error {
+	query := `INSERT INTO payment_events (id, type, payload, received_at) VALUES ($1, $2, $3, $4) ON CONFLICT (id) DO NOTHING`
+	_, err := tx.Exec(c, query, event.ID, event.Type, event.Payload, event.ReceivedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save event: %w", err)
+	}
+	return nil
+}
+
+func (e *EventQueryImpl) GetEvent(c context.Context, id string) (*PaymentEvent, error) {
+	query := `SELECT id, type, payload, received_at, processed_at, COALESCE(error, '') FROM payment_events WHERE id = $1`
+	var event PaymentEvent
+	err := e.db.QueryRow(c, query, id).Scan(&event.ID, &event.Type, &event.Payload, &event.ReceivedAt, &event.ProcessedAt, &event.Error)
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+func (e *EventQueryImpl) MarkEventProcessed(c context.Context, tx pgx.Tx, id, errMsg string) error {
+	query := `UPDATE payment_events SET processed_at = $1, error = NULLIF($2, '') WHERE id = $3`
+	_, err := tx.Exec(c, query, time.Now(), errMsg, id)
+	if err != nil {
+		return fmt.Errorf("failed to mark event processed: %w", err)
+	}
+	return nil
+}
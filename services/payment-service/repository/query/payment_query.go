@@ -0,0 +1,225 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	pb "github.com/daffaromero/retries/services/common/genproto/payment"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PaymentQuery interface {
+	CreatePayment(c context.Context, tx pgx.Tx, payment *pb.Payment, idempotencyKey string) (*pb.Payment, error)
+	GetPendingPayment(c context.Context, idempotencyKey string) (*pb.Payment, error)
+	GetPayment(c context.Context, req *pb.GetPaymentRequest) (*pb.Payment, error)
+	ListPayments(c context.Context, req *pb.ListPaymentsRequest) (*pb.ListPaymentsResponse, error)
+	UpdatePaymentStatus(c context.Context, tx pgx.Tx, id, status string) error
+	SettlePayment(c context.Context, tx pgx.Tx, sessionID, status string) (*pb.Payment, error)
+	ListOrderPayments(c context.Context, orderID, status string) ([]*pb.Payment, error)
+	LockRefundablePayment(c context.Context, tx pgx.Tx, orderID string) (*pb.Payment, error)
+	AddRefunded(c context.Context, tx pgx.Tx, id string, amount float64) (*pb.Payment, error)
+	GetRefund(c context.Context, tx pgx.Tx, paymentID, key string) (*Refund, error)
+	InsertRefund(c context.Context, tx pgx.Tx, refund *Refund) error
+	CompleteRefund(c context.Context, tx pgx.Tx, paymentID, key, refundID string) error
+	DeleteRefund(c context.Context, tx pgx.Tx, paymentID, key string) error
+}
+
+const (
+	RefundPending   = "pending"
+	RefundSucceeded = "succeeded"
+)
+
+// Refund is money returned from a payment, stored under the idempotency key
+// it was asked for with. A pending refund holds its amount against the
+// payment until the processor has made it.
+type Refund struct {
+	PaymentID      string
+	IdempotencyKey string
+	RefundID       string
+	Amount         float64
+	Status         string
+}
+
+const paymentColumns = `id, order_id, customer_id, amount, status, COALESCE(payment_link, ''), COALESCE(session_id, ''), refunded_amount`
+
+// scanPayment reads a row selected with paymentColumns.
+func scanPayment(row pgx.Row, payment *pb.Payment) error {
+	return row.Scan(&payment.Id, &payment.OrderId, &payment.CustomerId, &payment.Amount, &payment.Status, &payment.PaymentLink, &payment.SessionId, &payment.RefundedAmount)
+}
+
+type PaymentQueryImpl struct {
+	db *pgxpool.Pool
+}
+
+func NewPaymentQueryImpl(db *pgxpool.Pool) PaymentQuery {
+	return &PaymentQueryImpl{db: db}
+}
+
+func (p *PaymentQueryImpl) CreatePayment(c context.Context, tx pgx.Tx, req *pb.Payment, idempotencyKey string) (*pb.Payment, error) {
+	query := `INSERT INTO payments (id, order_id, customer_id, amount, status, payment_link, session_id, idempotency_key) VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, ''))`
+	_, err := tx.Exec(c, query, req.Id, req.OrderId, req.CustomerId, req.Amount, req.Status, req.PaymentLink, req.SessionId, idempotencyKey)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+	return req, nil
+}
+
+func (p *PaymentQueryImpl) GetPayment(c context.Context, req *pb.GetPaymentRequest) (*pb.Payment, error) {
+	query := `SELECT ` + paymentColumns + ` FROM payments WHERE id = $1`
+	var payment pb.Payment
+	err := scanPayment(p.db.QueryRow(c, query, req.Id), &payment)
+	if err != nil {
+		return nil, err
+	}
+	return &payment, nil
+}
+
+// GetPendingPayment returns the pending payment created under
+// idempotencyKey.
+func (p *PaymentQueryImpl) GetPendingPayment(c context.Context, idempotencyKey string) (*pb.Payment, error) {
+	query := `SELECT ` + paymentColumns + ` FROM payments WHERE idempotency_key = $1 AND status = 'pending'`
+	var payment pb.Payment
+	if err := scanPayment(p.db.QueryRow(c, query, idempotencyKey), &payment); err != nil {
+		return nil, err
+	}
+	return &payment, nil
+}
+
+func (p *PaymentQueryImpl) ListPayments(c context.Context, req *pb.ListPaymentsRequest) (*pb.ListPaymentsResponse, error) {
+	query := `SELECT ` + paymentColumns + ` FROM payments WHERE customer_id = $1 ORDER BY created_at DESC LIMIT NULLIF($2, 0) OFFSET $3`
+	rows, err := p.db.Query(c, query, req.CustomerId, req.Count, req.Start)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+
+	var payments []*pb.Payment
+	for rows.Next() {
+		var payment pb.Payment
+		if err := scanPayment(rows, &payment); err != nil {
+			return nil, fmt.Errorf("scan error: %w", err)
+		}
+		payments = append(payments, &payment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return &pb.ListPaymentsResponse{Payments: payments}, nil
+}
+
+func (p *PaymentQueryImpl) UpdatePaymentStatus(c context.Context, tx pgx.Tx, id, status string) error {
+	query := `UPDATE payments SET status = $1, updated_at = $2 WHERE id = $3`
+	_, err := tx.Exec(c, query, status, time.Now(), id)
+	if err != nil {
+		return fmt.Errorf("failed to update payment status: %w", err)
+	}
+	return nil
+}
+
+// SettlePayment moves the pending payment behind a checkout session to status.
+// A payment already in status is returned as is, so a settlement can be
+// repeated; one that has moved elsewhere is not found.
+func (p *PaymentQueryImpl) SettlePayment(c context.Context, tx pgx.Tx, sessionID, status string) (*pb.Payment, error) {
+	query := `UPDATE payments SET status = $1, updated_at = $2 WHERE session_id = $3 AND status IN ('pending', $1) RETURNING ` + paymentColumns
+	var payment pb.Payment
+	err := scanPayment(tx.QueryRow(c, query, status, time.Now(), sessionID), &payment)
+	if err != nil {
+		return nil, err
+	}
+	return &payment, nil
+}
+
+// ListOrderPayments returns the order's payments in status, most recent
+// first.
+func (p *PaymentQueryImpl) ListOrderPayments(c context.Context, orderID, status string) ([]*pb.Payment, error) {
+	query := `SELECT ` + paymentColumns + ` FROM payments WHERE order_id = $1 AND status = $2 ORDER BY created_at DESC`
+	rows, err := p.db.Query(c, query, orderID, status)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+
+	var payments []*pb.Payment
+	for rows.Next() {
+		var payment pb.Payment
+		if err := scanPayment(rows, &payment); err != nil {
+			return nil, fmt.Errorf("scan error: %w", err)
+		}
+		payments = append(payments, &payment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+	return payments, nil
+}
+
+// LockRefundablePayment locks the order's most recent paid or refunded
+// payment until tx ends.
+func (p *PaymentQueryImpl) LockRefundablePayment(c context.Context, tx pgx.Tx, orderID string) (*pb.Payment, error) {
+	query := `SELECT ` + paymentColumns + ` FROM payments WHERE order_id = $1 AND status IN ('paid', 'refunded') ORDER BY created_at DESC LIMIT 1 FOR UPDATE`
+	var payment pb.Payment
+	if err := scanPayment(tx.QueryRow(c, query, orderID), &payment); err != nil {
+		return nil, err
+	}
+	return &payment, nil
+}
+
+// AddRefunded adds amount, which may be negative, to what has been refunded
+// of the payment. The payment is refunded while nothing is left of it and
+// paid otherwise.
+func (p *PaymentQueryImpl) AddRefunded(c context.Context, tx pgx.Tx, id string, amount float64) (*pb.Payment, error) {
+	query := `UPDATE payments SET refunded_amount = refunded_amount + $1,
+		status = CASE WHEN refunded_amount + $1 >= amount THEN 'refunded' ELSE 'paid' END,
+		updated_at = $2
+		WHERE id = $3 RETURNING ` + paymentColumns
+	var payment pb.Payment
+	if err := scanPayment(tx.QueryRow(c, query, amount, time.Now(), id), &payment); err != nil {
+		return nil, fmt.Errorf("failed to update refunded amount: %w", err)
+	}
+	return &payment, nil
+}
+
+func (p *PaymentQueryImpl) GetRefund(c context.Context, tx pgx.Tx, paymentID, key string) (*Refund, error) {
+	query := `SELECT payment_id, idempotency_key, COALESCE(refund_id, ''), amount, status FROM refunds WHERE payment_id = $1 AND idempotency_key = $2`
+	var refund Refund
+	err := tx.QueryRow(c, query, paymentID, key).Scan(&refund.PaymentID, &refund.IdempotencyKey, &refund.RefundID, &refund.Amount, &refund.Status)
+	if err != nil {
+		return nil, err
+	}
+	return &refund, nil
+}
+
+func (p *PaymentQueryImpl) InsertRefund(c context.Context, tx pgx.Tx, refund *Refund) error {
+	query := `INSERT INTO refunds (payment_id, idempotency_key, refund_id, amount, status) VALUES ($1, $2, NULLIF($3, ''), $4, $5)`
+	_, err := tx.Exec(c, query, refund.PaymentID, refund.IdempotencyKey, refund.RefundID, refund.Amount, refund.Status)
+	if err != nil {
+		return fmt.Errorf("failed to insert refund: %w", err)
+	}
+	return nil
+}
+
+func (p *PaymentQueryImpl) CompleteRefund(c context.Context, tx pgx.Tx, paymentID, key, refundID string) error {
+	query := `UPDATE refunds SET refund_id = $1, status = $2 WHERE payment_id = $3 AND idempotency_key = $4`
+	_, err := tx.Exec(c, query, refundID, RefundSucceeded, paymentID, key)
+	if err != nil {
+		return fmt.Errorf("failed to complete refund: %w", err)
+	}
+	return nil
+}
+
+// DeleteRefund deletes a refund that is still pending.
+func (p *PaymentQueryImpl) DeleteRefund(c context.Context, tx pgx.Tx, paymentID, key string) error {
+	query := `DELETE FROM refunds WHERE payment_id = $1 AND idempotency_key = $2 AND status = $3`
+	tag, err := tx.Exec(c, query, paymentID, key, RefundPending)
+	if err != nil {
+		return fmt.Errorf("failed to delete refund: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	pb "github.com/daffaromero/retries/services/common/genproto/payment"
+	"github.com/daffaromero/retries/services/common/utils/logger"
+	"github.com/daffaromero/retries/services/payment-service/processor"
+	"github.com/daffaromero/retries/services/payment-service/repository"
+	"github.com/daffaromero/retries/services/payment-service/repository/query"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type PaymentService interface {
+	CreatePayment(context.Context, *pb.CreatePaymentRequest) (*pb.Payment, error)
+	GetPayment(context.Context, *pb.GetPaymentRequest) (*pb.Payment, error)
+	ListPayments(context.Context, *pb.ListPaymentsRequest) (*pb.ListPaymentsResponse, error)
+	CancelPayment(context.Context, *pb.CancelPaymentRequest) (*pb.Payment, error)
+	RefundPayment(context.Context, *pb.RefundPaymentRequest) (*pb.RefundPaymentResponse, error)
+}
+
+type paymentService struct {
+	payRepo   repository.PaymentRepository
+	processor processor.Processor
+	currency  string
+	logger    *logger.Log
+}
+
+func NewPaymentService(payRepo repository.PaymentRepository, processor processor.Processor, currency string, logger *logger.Log) PaymentService {
+	return &paymentService{
+		payRepo:   payRepo,
+		processor: processor,
+		currency:  currency,
+		logger:    logger,
+	}
+}
+
+// duplicate reports whether err is a unique constraint violation.
+func duplicate(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
+// minorUnits converts an amount in the currency's major unit to the minor
+// unit processors charge in.
+func minorUnits(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+
+// CreatePayment issues a checkout link for the order. A request carrying the
+// idempotency key of a payment that is still pending gets that payment back
+// instead of a second link.
+func (p *paymentService) CreatePayment(c context.Context, req *pb.CreatePaymentRequest) (*pb.Payment, error) {
+	if req.IdempotencyKey != "" {
+		pay, err := p.payRepo.GetPendingPayment(c, req.IdempotencyKey)
+		if err == nil {
+			return pay, nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			p.logger.CustomError("Failed to look up pending payment", err)
+			return nil, err
+		}
+	}
+
+	linkReq := &processor.LinkRequest{
+		OrderID:       req.OrderId,
+		CustomerID:    req.CustomerId,
+		CustomerEmail: req.CustomerEmail,
+		Currency:      req.Currency,
+		Amount:        minorUnits(req.Amount),
+	}
+	if linkReq.Currency == "" {
+		linkReq.Currency = p.currency
+	}
+	for _, it := range req.LineItems {
+		linkReq.Items = append(linkReq.Items, processor.LineItem{
+			Name:       it.Name,
+			UnitAmount: minorUnits(it.UnitAmount),
+			Quantity:   it.Quantity,
+		})
+	}
+
+	link, err := p.processor.CreatePaymentLink(c, linkReq)
+	if err != nil {
+		p.logger.CustomError("Failed to create payment link", err)
+		return nil, err
+	}
+
+	payment := &pb.Payment{
+		Id:          uuid.New().String(),
+		OrderId:     req.OrderId,
+		CustomerId:  req.CustomerId,
+		Amount:      float64(linkReq.Total()) / 100,
+		Status:      string(processor.StatusPending),
+		PaymentLink: link.URL,
+		SessionId:   link.SessionID,
+	}
+
+	res, err := p.payRepo.CreatePayment(c, payment, req.IdempotencyKey)
+	if duplicate(err) && req.IdempotencyKey != "" {
+		// A concurrent request with the same key stored its payment first.
+		if err := p.processor.Cancel(c, link.SessionID); err != nil {
+			p.logger.CustomError(fmt.Sprintf("Failed to cancel duplicate payment session %s", link.SessionID), err)
+		}
+		res, err = p.payRepo.GetPendingPayment(c, req.IdempotencyKey)
+	}
+	if err != nil {
+		p.logger.CustomError("Payment creation failed", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (p *paymentService) GetPayment(c context.Context, req *pb.GetPaymentRequest) (*pb.Payment, error) {
+	res, err := p.payRepo.GetPayment(c, req)
+	if err != nil {
+		p.logger.CustomError("Failed to get payment by ID", err)
+		return nil, err
+	}
+	if res.Status != string(processor.StatusPending) || res.SessionId == "" {
+		return res, nil
+	}
+
+	st, err := p.processor.GetPaymentStatus(c, res.SessionId)
+	if err != nil {
+		p.logger.CustomError("Failed to fetch payment status", err)
+		return res, nil
+	}
+	if string(st) != res.Status {
+		if err := p.payRepo.UpdatePaymentStatus(c, res.Id, string(st)); err != nil {
+			p.logger.CustomError("Failed to update payment status", err)
+			return res, nil
+		}
+		res.Status = string(st)
+	}
+	return res, nil
+}
+
+func (p *paymentService) ListPayments(c context.Context, req *pb.ListPaymentsRequest) (*pb.ListPaymentsResponse, error) {
+	res, err := p.payRepo.ListPayments(c, req)
+	if err != nil {
+		p.logger.CustomError("Failed to list payments", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+// CancelPayment voids every checkout link still open for the order and
+// returns the most recent one. A link the processor has already expired or
+// cancelled counts as voided.
+func (p *paymentService) CancelPayment(c context.Context, req *pb.CancelPaymentRequest) (*pb.Payment, error) {
+	pays, err := p.payRepo.ListOrderPayments(c, req.OrderId, string(processor.StatusPending))
+	if err != nil {
+		p.logger.CustomError("Failed to get pending payments", err)
+		return nil, err
+	}
+	if len(pays) == 0 {
+		return nil, pgx.ErrNoRows
+	}
+	for _, pay := range pays {
+		if err := p.processor.Cancel(c, pay.SessionId); err != nil {
+			p.logger.CustomError(fmt.Sprintf("Failed to cancel payment session %s", pay.SessionId), err)
+			return nil, err
+		}
+		if err := p.payRepo.UpdatePaymentStatus(c, pay.Id, string(processor.StatusCanceled)); err != nil {
+			p.logger.CustomError("Failed to update payment status", err)
+			return nil, err
+		}
+		pay.Status = string(processor.StatusCanceled)
+	}
+	return pays[0], nil
+}
+
+// RefundPayment returns amount of the order's paid payment to the customer,
+// or whatever is left of it when amount is zero. The payment is marked
+// refunded once nothing is left to return.
+//
+// The refund is held back from the payment under its idempotency key before
+// the processor is asked for it, so concurrent refunds cannot together return
+// more than was paid. A request repeated with the same key gets the refund
+// stored the first time; one whose first attempt was cut short asks the
+// processor again with the same key, which makes the refund at most once.
+func (p *paymentService) RefundPayment(c context.Context, req *pb.RefundPaymentRequest) (*pb.RefundPaymentResponse, error) {
+	key := req.IdempotencyKey
+	if key == "" {
+		key = uuid.NewString()
+	}
+	ref, err := p.payRepo.ReserveRefund(c, req.OrderId, key, func(pay *pb.Payment) (float64, error) {
+		left := minorUnits(pay.Amount - pay.RefundedAmount)
+		amount := minorUnits(req.Amount)
+		if amount == 0 {
+			amount = left
+		}
+		if amount == 0 || amount > left {
+			return 0, processor.ErrRefundTooLarge
+		}
+		return float64(amount) / 100, nil
+	})
+	if err != nil {
+		p.logger.CustomError("Failed to reserve refund", err)
+		return nil, err
+	}
+
+	if ref.Status != query.RefundSucceeded {
+		pay, err := p.payRepo.GetPayment(c, &pb.GetPaymentRequest{Id: ref.PaymentID})
+		if err != nil {
+			p.logger.CustomError("Failed to get refunded payment", err)
+			return nil, err
+		}
+		made, err := p.processor.Refund(c, pay.SessionId, minorUnits(ref.Amount), key)
+		if err != nil {
+			p.logger.CustomError("Failed to refund payment", err)
+			if relErr := p.payRepo.ReleaseRefund(c, ref); relErr != nil {
+				p.logger.CustomError("Failed to release refund", relErr)
+			}
+			return nil, err
+		}
+		ref.RefundID = made.ID
+		if err := p.payRepo.CompleteRefund(c, ref); err != nil {
+			p.logger.CustomError("Failed to record refund", err)
+			return nil, err
+		}
+	}
+
+	pay, err := p.payRepo.GetPayment(c, &pb.GetPaymentRequest{Id: ref.PaymentID})
+	if err != nil {
+		p.logger.CustomError("Failed to get refunded payment", err)
+		return nil, err
+	}
+	return &pb.RefundPaymentResponse{
+		RefundId: ref.RefundID,
+		Amount:   ref.Amount,
+		Payment:  pay,
+	}, nil
+}
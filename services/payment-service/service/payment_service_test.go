@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	pb "github.com/daffaromero/retries/services/common/genproto/payment"
+	"github.com/daffaromero/retries/services/payment-service/processor"
+	"github.com/jackc/pgx/v5"
+)
+
+// paid creates a payment of 10 for order-1 and settles it.
+func (f *webhookFixture) paid(t *testing.T) {
+	t.Helper()
+	payload, err := f.fake.Complete(f.checkout(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.ingest(t, payload); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRefundIsIdempotent(t *testing.T) {
+	f := newWebhookFixture(t)
+	f.paid(t)
+	refund := func(key string, amount float64) (*pb.RefundPaymentResponse, error) {
+		return f.payServ.RefundPayment(context.Background(), &pb.RefundPaymentRequest{OrderId: "order-1", Amount: amount, IdempotencyKey: key})
+	}
+
+	first, err := refund("order-1/0", 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := refund("order-1/0", 4)
+	if err != nil {
+		t.Fatalf("repeated refund: %v", err)
+	}
+	if again.RefundId != first.RefundId || again.Payment.RefundedAmount != 4 {
+		t.Errorf("repeated refund = %s with %v refunded, want %s with 4", again.RefundId, again.Payment.RefundedAmount, first.RefundId)
+	}
+
+	// The last refund still answers its retry once nothing is left.
+	last, err := refund("order-1/1", 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last.Payment.Status != string(processor.StatusRefunded) {
+		t.Errorf("payment status = %s after refunding everything, want refunded", last.Payment.Status)
+	}
+	if again, err := refund("order-1/1", 6); err != nil || again.RefundId != last.RefundId {
+		t.Errorf("retried last refund = %v, %v, want %s", again, err, last.RefundId)
+	}
+
+	if _, err := refund("order-1/2", 1); !errors.Is(err, processor.ErrRefundTooLarge) {
+		t.Errorf("refund past the amount paid = %v, want ErrRefundTooLarge", err)
+	}
+	if got := f.payments.payments[first.Payment.Id].RefundedAmount; got != 10 {
+		t.Errorf("refunded amount = %v, want 10", got)
+	}
+}
+
+func TestFailedRefundIsReleased(t *testing.T) {
+	f := newWebhookFixture(t)
+	f.paid(t)
+
+	// The processor rejects a refund the payment's own ledger allows.
+	var pay *pb.Payment
+	for _, p := range f.payments.payments {
+		pay = p
+	}
+	pay.Amount = 20
+	if _, err := f.payServ.RefundPayment(context.Background(), &pb.RefundPaymentRequest{OrderId: "order-1", Amount: 15, IdempotencyKey: "k"}); !errors.Is(err, processor.ErrRefundTooLarge) {
+		t.Fatalf("refund = %v, want the processor's ErrRefundTooLarge", err)
+	}
+	if pay.RefundedAmount != 0 || len(f.payments.refunds) != 0 {
+		t.Errorf("failed refund left %v refunded and %d refunds", pay.RefundedAmount, len(f.payments.refunds))
+	}
+}
+
+func TestCancelVoidsEveryPendingPayment(t *testing.T) {
+	f := newWebhookFixture(t)
+	stale := f.checkout(t)
+	f.checkout(t)
+	// The first link expired at the processor before its webhook arrived.
+	if _, err := f.fake.Expire(stale); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := f.payServ.CancelPayment(context.Background(), &pb.CancelPaymentRequest{OrderId: "order-1"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range f.payments.payments {
+		if p.Status != string(processor.StatusCanceled) {
+			t.Errorf("payment %s is %s, want canceled", p.Id, p.Status)
+		}
+	}
+
+	if _, err := f.payServ.CancelPayment(context.Background(), &pb.CancelPaymentRequest{OrderId: "order-1"}); !errors.Is(err, pgx.ErrNoRows) {
+		t.Errorf("CancelPayment with nothing pending = %v, want pgx.ErrNoRows", err)
+	}
+}
+
+func TestCreatePaymentIsIdempotent(t *testing.T) {
+	f := newWebhookFixture(t)
+	create := func() *pb.Payment {
+		t.Helper()
+		pay, err := f.payServ.CreatePayment(context.Background(), &pb.CreatePaymentRequest{OrderId: "order-1", Amount: 10, IdempotencyKey: "send-order-1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return pay
+	}
+
+	first := create()
+	if again := create(); again.Id != first.Id || again.PaymentLink != first.PaymentLink {
+		t.Errorf("retried CreatePayment = %s, want %s", again.Id, first.Id)
+	}
+
+	// Once the payment is no longer pending the key issues a new one.
+	if _, err := f.payServ.CancelPayment(context.Background(), &pb.CancelPaymentRequest{OrderId: "order-1"}); err != nil {
+		t.Fatal(err)
+	}
+	if next := create(); next.Id == first.Id {
+		t.Error("CreatePayment returned a cancelled payment")
+	}
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/daffaromero/retries/services/common/discovery"
+	pbApi "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	"github.com/daffaromero/retries/services/common/utils/logger"
+	"github.com/daffaromero/retries/services/payment-service/processor"
+	"github.com/daffaromero/retries/services/payment-service/repository"
+	"github.com/daffaromero/retries/services/payment-service/repository/query"
+	"github.com/gofiber/fiber/v3"
+	"github.com/jackc/pgx/v5"
+	"github.com/stripe/stripe-go/v79"
+	"github.com/stripe/stripe-go/v79/webhook"
+)
+
+// WebhookService ingests payment events pushed by Stripe. Every verified event
+// is stored before it is handled so that it can be replayed later, either to
+// recover from a failed settlement or to drive flows locally.
+type WebhookService interface {
+	Ingest(c context.Context, payload []byte, signature string) error
+	Replay(c context.Context, eventID string) error
+}
+
+type webhookService struct {
+	secret    string
+	payRepo   repository.PaymentRepository
+	eventRepo repository.EventRepository
+	registry  discovery.Registry
+	logger    *logger.Log
+
+	mu          sync.Mutex
+	orderClient pbApi.OrderServiceClient
+}
+
+func NewWebhookService(secret string, registry discovery.Registry, payRepo repository.PaymentRepository, eventRepo repository.EventRepository, logger *logger.Log) WebhookService {
+	return &webhookService{
+		secret:    secret,
+		payRepo:   payRepo,
+		eventRepo: eventRepo,
+		registry:  registry,
+		logger:    logger,
+	}
+}
+
+func (w *webhookService) Ingest(c context.Context, payload []byte, signature string) error {
+	event, err := webhook.ConstructEventWithOptions(payload, signature, w.secret, webhook.ConstructEventOptions{
+		IgnoreAPIVersionMismatch: true,
+	})
+	if err != nil {
+		w.logger.CustomError("Rejected webhook event", err)
+		return fiber.NewError(fiber.StatusBadRequest, "Invalid webhook signature.")
+	}
+
+	stored, err := w.eventRepo.GetEvent(c, event.ID)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		if err := w.eventRepo.SaveEvent(c, &query.PaymentEvent{
+			ID:         event.ID,
+			Type:       string(event.Type),
+			Payload:    payload,
+			ReceivedAt: time.Now(),
+		}); err != nil {
+			w.logger.CustomError("Failed to store webhook event", err)
+			return err
+		}
+	case err != nil:
+		w.logger.CustomError("Failed to look up webhook event", err)
+		return err
+	case stored.ProcessedAt != nil && stored.Error == "":
+		// Stripe delivers at least once; settled events are acknowledged as is.
+		return nil
+	}
+	return w.process(c, event)
+}
+
+func (w *webhookService) Replay(c context.Context, eventID string) error {
+	stored, err := w.eventRepo.GetEvent(c, eventID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fiber.NewError(fiber.StatusNotFound, "Event not found.")
+	}
+	if err != nil {
+		w.logger.CustomError("Failed to get webhook event", err)
+		return err
+	}
+
+	var event stripe.Event
+	if err := json.Unmarshal(stored.Payload, &event); err != nil {
+		w.logger.CustomError("Failed to decode stored webhook event", err)
+		return err
+	}
+	return w.process(c, event)
+}
+
+func (w *webhookService) process(c context.Context, event stripe.Event) error {
+	err := w.handle(c, event)
+	var errMsg string
+	if err != nil {
+		w.logger.CustomError(fmt.Sprintf("Failed to handle %s event %s", event.Type, event.ID), err)
+		errMsg = err.Error()
+	}
+	if markErr := w.eventRepo.MarkEventProcessed(c, event.ID, errMsg); markErr != nil {
+		w.logger.CustomError("Failed to mark webhook event processed", markErr)
+	}
+	return err
+}
+
+func (w *webhookService) handle(c context.Context, event stripe.Event) error {
+	switch event.Type {
+	case stripe.EventTypeCheckoutSessionCompleted, stripe.EventTypeCheckoutSessionExpired:
+		var sess stripe.CheckoutSession
+		if err := json.Unmarshal(event.Data.Raw, &sess); err != nil {
+			return fmt.Errorf("failed to decode checkout session: %w", err)
+		}
+		st := processor.StatusExpired
+		if event.Type == stripe.EventTypeCheckoutSessionCompleted {
+			// Delayed payment methods complete the session before the money
+			// arrives; those settle through a later event.
+			if sess.PaymentStatus != stripe.CheckoutSessionPaymentStatusPaid {
+				return nil
+			}
+			st = processor.StatusPaid
+		}
+		return w.settle(c, sess.ID, sess.Metadata["order_id"], st)
+	case stripe.EventTypePaymentIntentPaymentFailed:
+		// A declined charge leaves the checkout session open for another
+		// attempt, so the payment stays pending and can still be cancelled.
+		var intent stripe.PaymentIntent
+		if err := json.Unmarshal(event.Data.Raw, &intent); err != nil {
+			return fmt.Errorf("failed to decode payment intent: %w", err)
+		}
+		w.logger.Error(fmt.Sprintf("Payment attempt failed for order %s", intent.Metadata["order_id"]))
+		return nil
+	default:
+		return nil
+	}
+}
+
+func (w *webhookService) settle(c context.Context, sessionID, orderID string, st processor.Status) error {
+	if orderID == "" {
+		return errors.New("event carries no order_id metadata")
+	}
+
+	pay, err := w.payRepo.SettlePayment(c, sessionID, string(st))
+	if errors.Is(err, pgx.ErrNoRows) {
+		// The payment was cancelled or settled otherwise; the order followed
+		// it then and must not be moved again by this event.
+		w.logger.Error(fmt.Sprintf("No payment of order %s left to settle as %s", orderID, st))
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to settle payment: %w", err)
+	}
+
+	client, err := w.orders(c)
+	if err != nil {
+		return err
+	}
+	req := &pbApi.SettleOrderRequest{
+		OrderId:          orderID,
+		PaymentId:        pay.Id,
+		SettlementStatus: string(st),
+	}
+	if _, err := client.SettleOrder(c, req); err != nil {
+		return fmt.Errorf("failed to settle order: %w", err)
+	}
+	return nil
+}
+
+// orders connects to order-service on first use. order-service dials
+// payment-service at startup, so dialing back eagerly would deadlock a cold
+// start of both.
+func (w *webhookService) orders(c context.Context) (pbApi.OrderServiceClient, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.orderClient != nil {
+		return w.orderClient, nil
+	}
+	conn, err := discovery.ConnectToService(c, discovery.OrderServiceName, w.registry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to order service: %w", err)
+	}
+	w.orderClient = pbApi.NewOrderServiceClient(conn)
+	return w.orderClient, nil
+}
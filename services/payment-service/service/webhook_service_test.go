@@ -0,0 +1,456 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	pbApi "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	pb "github.com/daffaromero/retries/services/common/genproto/payment"
+	"github.com/daffaromero/retries/services/common/utils/logger"
+	"github.com/daffaromero/retries/services/payment-service/processor"
+	"github.com/daffaromero/retries/services/payment-service/repository/query"
+	"github.com/gofiber/fiber/v3"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc"
+)
+
+const testSecret = "whsec_test"
+
+// fakePayments keeps payments in memory, keyed by ID, along with the
+// idempotency keys they were created under and refunds keyed by payment ID
+// and idempotency key.
+type fakePayments struct {
+	mu       sync.Mutex
+	payments map[string]*pb.Payment
+	keys     map[string]string
+	refunds  map[string]*query.Refund
+}
+
+func (f *fakePayments) CreatePayment(c context.Context, p *pb.Payment, idempotencyKey string) (*pb.Payment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if idempotencyKey != "" {
+		if _, ok := f.pendingPayment(idempotencyKey); ok {
+			return nil, &pgconn.PgError{Code: "23505"}
+		}
+		f.keys[p.Id] = idempotencyKey
+	}
+	f.payments[p.Id] = p
+	return p, nil
+}
+
+func (f *fakePayments) GetPendingPayment(c context.Context, idempotencyKey string) (*pb.Payment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	p, ok := f.pendingPayment(idempotencyKey)
+	if !ok {
+		return nil, pgx.ErrNoRows
+	}
+	return p, nil
+}
+
+func (f *fakePayments) pendingPayment(idempotencyKey string) (*pb.Payment, bool) {
+	for id, key := range f.keys {
+		if p := f.payments[id]; key == idempotencyKey && p.Status == string(processor.StatusPending) {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+func (f *fakePayments) GetPayment(c context.Context, req *pb.GetPaymentRequest) (*pb.Payment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	p, ok := f.payments[req.Id]
+	if !ok {
+		return nil, pgx.ErrNoRows
+	}
+	return p, nil
+}
+
+func (f *fakePayments) ListPayments(c context.Context, req *pb.ListPaymentsRequest) (*pb.ListPaymentsResponse, error) {
+	return &pb.ListPaymentsResponse{}, nil
+}
+
+func (f *fakePayments) UpdatePaymentStatus(c context.Context, id, status string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	p, ok := f.payments[id]
+	if !ok {
+		return pgx.ErrNoRows
+	}
+	p.Status = status
+	return nil
+}
+
+func (f *fakePayments) SettlePayment(c context.Context, sessionID, status string) (*pb.Payment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, p := range f.payments {
+		if p.SessionId == sessionID && (p.Status == string(processor.StatusPending) || p.Status == status) {
+			p.Status = status
+			return p, nil
+		}
+	}
+	return nil, pgx.ErrNoRows
+}
+
+func (f *fakePayments) ListOrderPayments(c context.Context, orderID, status string) ([]*pb.Payment, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var pays []*pb.Payment
+	for _, p := range f.payments {
+		if p.OrderId == orderID && p.Status == status {
+			pays = append(pays, p)
+		}
+	}
+	return pays, nil
+}
+
+func (f *fakePayments) ReserveRefund(c context.Context, orderID, key string, size func(*pb.Payment) (float64, error)) (*query.Refund, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var pay *pb.Payment
+	for _, p := range f.payments {
+		if p.OrderId == orderID && (p.Status == string(processor.StatusPaid) || p.Status == string(processor.StatusRefunded)) {
+			pay = p
+		}
+	}
+	if pay == nil {
+		return nil, pgx.ErrNoRows
+	}
+	if ref, ok := f.refunds[pay.Id+"/"+key]; ok {
+		copied := *ref
+		return &copied, nil
+	}
+	amount, err := size(pay)
+	if err != nil {
+		return nil, err
+	}
+	ref := &query.Refund{PaymentID: pay.Id, IdempotencyKey: key, Amount: amount, Status: query.RefundPending}
+	f.refunds[pay.Id+"/"+key] = ref
+	f.addRefunded(pay, amount)
+	copied := *ref
+	return &copied, nil
+}
+
+func (f *fakePayments) CompleteRefund(c context.Context, refund *query.Refund) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ref, ok := f.refunds[refund.PaymentID+"/"+refund.IdempotencyKey]
+	if !ok {
+		return pgx.ErrNoRows
+	}
+	ref.RefundID = refund.RefundID
+	ref.Status = query.RefundSucceeded
+	return nil
+}
+
+func (f *fakePayments) ReleaseRefund(c context.Context, refund *query.Refund) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	id := refund.PaymentID + "/" + refund.IdempotencyKey
+	ref, ok := f.refunds[id]
+	if !ok || ref.Status != query.RefundPending {
+		return pgx.ErrNoRows
+	}
+	delete(f.refunds, id)
+	f.addRefunded(f.payments[ref.PaymentID], -ref.Amount)
+	return nil
+}
+
+func (f *fakePayments) addRefunded(pay *pb.Payment, amount float64) {
+	pay.RefundedAmount += amount
+	pay.Status = string(processor.StatusPaid)
+	if minorUnits(pay.RefundedAmount) >= minorUnits(pay.Amount) {
+		pay.Status = string(processor.StatusRefunded)
+	}
+}
+
+// fakeEvents keeps webhook events in memory, keyed by ID.
+type fakeEvents struct {
+	mu     sync.Mutex
+	events map[string]*query.PaymentEvent
+}
+
+func (f *fakeEvents) SaveEvent(c context.Context, event *query.PaymentEvent) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.events[event.ID] = event
+	return nil
+}
+
+func (f *fakeEvents) GetEvent(c context.Context, id string) (*query.PaymentEvent, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	e, ok := f.events[id]
+	if !ok {
+		return nil, pgx.ErrNoRows
+	}
+	copied := *e
+	return &copied, nil
+}
+
+func (f *fakeEvents) MarkEventProcessed(c context.Context, id, errMsg string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	e, ok := f.events[id]
+	if !ok {
+		return pgx.ErrNoRows
+	}
+	now := time.Now()
+	e.ProcessedAt = &now
+	e.Error = errMsg
+	return nil
+}
+
+// fakeOrders records the settlements sent to order-service.
+type fakeOrders struct {
+	pbApi.OrderServiceClient
+	settled []*pbApi.SettleOrderRequest
+	err     error
+}
+
+func (f *fakeOrders) SettleOrder(ctx context.Context, req *pbApi.SettleOrderRequest, opts ...grpc.CallOption) (*pbApi.Order, error) {
+	f.settled = append(f.settled, req)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &pbApi.Order{Id: req.OrderId, SettlementStatus: req.SettlementStatus}, nil
+}
+
+type webhookFixture struct {
+	fake     *processor.Fake
+	payments *fakePayments
+	events   *fakeEvents
+	orders   *fakeOrders
+	payServ  PaymentService
+	whServ   WebhookService
+}
+
+func newWebhookFixture(t *testing.T) *webhookFixture {
+	t.Helper()
+	log := logger.NewLog("test")
+	f := &webhookFixture{
+		fake:     processor.NewFake("http://gateway"),
+		payments: &fakePayments{payments: map[string]*pb.Payment{}, keys: map[string]string{}, refunds: map[string]*query.Refund{}},
+		events:   &fakeEvents{events: map[string]*query.PaymentEvent{}},
+		orders:   &fakeOrders{},
+	}
+	f.payServ = NewPaymentService(f.payments, f.fake, "usd", log)
+	whServ := NewWebhookService(testSecret, nil, f.payments, f.events, log).(*webhookService)
+	whServ.orderClient = f.orders
+	f.whServ = whServ
+	return f
+}
+
+// checkout creates a payment for order-1 and returns its session ID.
+func (f *webhookFixture) checkout(t *testing.T) string {
+	t.Helper()
+	pay, err := f.payServ.CreatePayment(context.Background(), &pb.CreatePaymentRequest{OrderId: "order-1", Amount: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pay.SessionId
+}
+
+func (f *webhookFixture) ingest(t *testing.T, payload []byte) error {
+	t.Helper()
+	return f.whServ.Ingest(context.Background(), payload, processor.SignEvent(payload, testSecret))
+}
+
+func (f *webhookFixture) paymentStatus(t *testing.T) string {
+	t.Helper()
+	for _, p := range f.payments.payments {
+		return p.Status
+	}
+	t.Fatal("no payment")
+	return ""
+}
+
+func TestIngestRejectsBadSignature(t *testing.T) {
+	f := newWebhookFixture(t)
+	payload, err := f.fake.Complete(f.checkout(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, signature := range map[string]string{
+		"missing":      "",
+		"other secret": processor.SignEvent(payload, "whsec_other"),
+		"other body":   processor.SignEvent([]byte(`{"id":"evt_other"}`), testSecret),
+	} {
+		err := f.whServ.Ingest(context.Background(), payload, signature)
+		var fe *fiber.Error
+		if !errors.As(err, &fe) || fe.Code != http.StatusBadRequest {
+			t.Errorf("%s signature: %v, want a 400", name, err)
+		}
+	}
+	if len(f.events.events) != 0 || len(f.orders.settled) != 0 {
+		t.Errorf("rejected event was stored or handled")
+	}
+	if got := f.paymentStatus(t); got != string(processor.StatusPending) {
+		t.Errorf("payment status = %s, want pending", got)
+	}
+}
+
+func TestIngestEvents(t *testing.T) {
+	tests := []struct {
+		name        string
+		event       func(*processor.Fake, string) ([]byte, error)
+		wantPayment processor.Status
+		wantSettled string
+	}{
+		{"completed", (*processor.Fake).Complete, processor.StatusPaid, "paid"},
+		{"expired", (*processor.Fake).Expire, processor.StatusExpired, "expired"},
+		{"payment failed", (*processor.Fake).Fail, processor.StatusPending, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newWebhookFixture(t)
+			payload, err := tt.event(f.fake, f.checkout(t))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := f.ingest(t, payload); err != nil {
+				t.Fatal(err)
+			}
+
+			if got := f.paymentStatus(t); got != string(tt.wantPayment) {
+				t.Errorf("payment status = %s, want %s", got, tt.wantPayment)
+			}
+			switch {
+			case tt.wantSettled == "" && len(f.orders.settled) != 0:
+				t.Errorf("order settled as %s, want untouched", f.orders.settled[0].SettlementStatus)
+			case tt.wantSettled != "" && (len(f.orders.settled) != 1 || f.orders.settled[0].SettlementStatus != tt.wantSettled):
+				t.Errorf("settlements = %v, want one %s", f.orders.settled, tt.wantSettled)
+			}
+			for id, e := range f.events.events {
+				if e.ProcessedAt == nil || e.Error != "" {
+					t.Errorf("event %s processed at %v with error %q", id, e.ProcessedAt, e.Error)
+				}
+			}
+		})
+	}
+}
+
+func TestFailedPaymentCanStillBeCancelled(t *testing.T) {
+	f := newWebhookFixture(t)
+	sessionID := f.checkout(t)
+	payload, err := f.fake.Fail(sessionID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.ingest(t, payload); err != nil {
+		t.Fatal(err)
+	}
+
+	pay, err := f.payServ.CancelPayment(context.Background(), &pb.CancelPaymentRequest{OrderId: "order-1"})
+	if err != nil {
+		t.Fatalf("CancelPayment after a failed charge: %v", err)
+	}
+	if pay.Status != string(processor.StatusCanceled) {
+		t.Errorf("payment status = %s, want canceled", pay.Status)
+	}
+}
+
+func TestIngestSkipsSettledPayment(t *testing.T) {
+	f := newWebhookFixture(t)
+	payload, err := f.fake.Expire(f.checkout(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The order was cancelled before the expiry arrived.
+	if _, err := f.payServ.CancelPayment(context.Background(), &pb.CancelPaymentRequest{OrderId: "order-1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := f.ingest(t, payload); err != nil {
+		t.Fatal(err)
+	}
+	if got := f.paymentStatus(t); got != string(processor.StatusCanceled) {
+		t.Errorf("payment status = %s, want canceled", got)
+	}
+	if len(f.orders.settled) != 0 {
+		t.Errorf("order settled as %s for a cancelled payment", f.orders.settled[0].SettlementStatus)
+	}
+}
+
+func TestIngestIsIdempotent(t *testing.T) {
+	f := newWebhookFixture(t)
+	payload, err := f.fake.Complete(f.checkout(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if err := f.ingest(t, payload); err != nil {
+			t.Fatalf("delivery %d: %v", i+1, err)
+		}
+	}
+	if len(f.orders.settled) != 1 {
+		t.Errorf("order settled %d times, want once", len(f.orders.settled))
+	}
+}
+
+func TestIngestRetriesFailedEvent(t *testing.T) {
+	f := newWebhookFixture(t)
+	payload, err := f.fake.Complete(f.checkout(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f.orders.err = errors.New("order service unavailable")
+	if err := f.ingest(t, payload); err == nil {
+		t.Fatal("Ingest succeeded while order-service was down")
+	}
+	f.orders.err = nil
+	if err := f.ingest(t, payload); err != nil {
+		t.Fatalf("redelivery: %v", err)
+	}
+
+	last := f.orders.settled[len(f.orders.settled)-1]
+	if len(f.orders.settled) != 2 || last.SettlementStatus != "paid" {
+		t.Errorf("settlements = %v, want a second paid", f.orders.settled)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	f := newWebhookFixture(t)
+	payload, err := f.fake.Complete(f.checkout(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.ingest(t, payload); err != nil {
+		t.Fatal(err)
+	}
+
+	var eventID string
+	for id := range f.events.events {
+		eventID = id
+	}
+	for i := 0; i < 2; i++ {
+		if err := f.whServ.Replay(context.Background(), eventID); err != nil {
+			t.Fatalf("replay %d: %v", i+1, err)
+		}
+	}
+
+	if got := f.paymentStatus(t); got != string(processor.StatusPaid) {
+		t.Errorf("payment status = %s, want paid", got)
+	}
+	for _, req := range f.orders.settled {
+		if req.SettlementStatus != "paid" {
+			t.Errorf("replay settled order as %s, want paid", req.SettlementStatus)
+		}
+	}
+
+	err = f.whServ.Replay(context.Background(), "evt_missing")
+	var fe *fiber.Error
+	if !errors.As(err, &fe) || fe.Code != http.StatusNotFound {
+		t.Errorf("Replay(missing) = %v, want a 404", err)
+	}
+}
@@ -7,12 +7,16 @@ import (
 	"github.com/daffaromero/retries/services/common/utils"
 )
 
-var EndpointPrefix = utils.GetEnv("ENDPOINT_PREFIX")
+var (
+	EndpointPrefix = utils.GetEnv("ENDPOINT_PREFIX")
+)
 
 type ServerConfig struct {
-	URI  string
-	Port string
-	Host string
+	URI      string
+	Port     string
+	Host     string
+	GRPCPort string
+	GRPCHost string
 }
 
 func NewServerConfig() ServerConfig {
@@ -24,9 +28,15 @@ func NewServerConfig() ServerConfig {
 	if port == "" {
 		log.Fatal("SERVER_PORT environment variable is not set")
 	}
+	grpcPort := utils.GetEnv("GRPC_PORT")
+	if grpcPort == "" {
+		log.Fatal("GRPC_PORT environment variable is not set")
+	}
 	return ServerConfig{
-		URI:  uri,
-		Port: port,
-		Host: fmt.Sprintf("%s:%s", uri, port),
+		URI:      uri,
+		Port:     port,
+		Host:     fmt.Sprintf("%s:%s", uri, port),
+		GRPCPort: grpcPort,
+		GRPCHost: fmt.Sprintf("%s:%s", uri, grpcPort),
 	}
 }
@@ -1,7 +1,7 @@
 package controller
 
 import (
-	"fmt"
+	"errors"
 	"strconv"
 
 	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
@@ -58,7 +58,7 @@ func (c *CategoryControllerImpl) CreateCategory(ctx fiber.Ctx) error {
 
 	res, err := c.categoryService.CreateCategory(ctx.Context(), &req, req.Name, req.Description)
 	if err != nil {
-		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		return categoryError(ctx, err)
 	}
 
 	return ctx.Status(fiber.StatusOK).JSON(res)
@@ -66,13 +66,13 @@ func (c *CategoryControllerImpl) CreateCategory(ctx fiber.Ctx) error {
 
 func (c *CategoryControllerImpl) GetCategoryByID(ctx fiber.Ctx) error {
 	var req pb.GetCategoryFilter
-	req.Id = ctx.Query("id")
+	req.Id = ctx.Params("id")
 	if req.Id == "" {
 		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "id not provided"})
 	}
 	cat, err := c.categoryService.GetCategoryByID(ctx.Context(), &req)
 	if err != nil {
-		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "failed to get category by id"})
+		return categoryError(ctx, err)
 	}
 	res := &pb.GetCategoryResponse{
 		Categories: cat.Categories,
@@ -82,16 +82,21 @@ func (c *CategoryControllerImpl) GetCategoryByID(ctx fiber.Ctx) error {
 
 func (c *CategoryControllerImpl) GetCategories(ctx fiber.Ctx) error {
 	var fil pb.GetCategoryFilter
-	err := ctx.Bind().Body(&fil)
-	if err != nil {
-		return fmt.Errorf("error binding request - %s", err)
-	}
 	offset, _ := strconv.Atoi(ctx.Query("offset"))
 	limit, _ := strconv.Atoi(ctx.Query("limit"))
 	page, _ := strconv.Atoi(ctx.Query("page"))
-	fil.Pagination.Offset = int32(offset)
-	fil.Pagination.Limit = int32(limit)
-	fil.Pagination.Page = int32(page)
+	fil.Search = ctx.Query("search")
+	fil.Pagination = &pb.Pagination{
+		Offset:    int32(offset),
+		Limit:     int32(limit),
+		Page:      int32(page),
+		Cursor:    ctx.Query("cursor"),
+		WithTotal: ctx.Query("with_total") == "true",
+	}
+	fil.Sorting = &pb.Sorting{
+		OrderBy:    ctx.Query("order_by"),
+		IsReversed: ctx.Query("reversed") == "true",
+	}
 
 	categories, err := c.categoryService.GetCategories(ctx.Context(), &fil)
 	if err != nil {
@@ -101,18 +106,18 @@ func (c *CategoryControllerImpl) GetCategories(ctx fiber.Ctx) error {
 }
 
 func (c *CategoryControllerImpl) UpdateCategory(ctx fiber.Ctx) error {
-	var req *pb.Category
-	req.Id = ctx.Query("id")
-	if req.Id == "" {
-		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "id not provided"})
-	}
+	var req pb.Category
 	err := ctx.Bind().Body(&req)
 	if err != nil {
 		return fiber.ErrBadRequest
 	}
-	cat, err := c.categoryService.UpdateCategory(ctx.Context(), req, req.Name, req.Description)
+	req.Id = ctx.Params("id")
+	if req.Id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "id not provided"})
+	}
+	cat, err := c.categoryService.UpdateCategory(ctx.Context(), &req, req.Name, req.Description)
 	if err != nil {
-		return fiber.ErrInternalServerError
+		return categoryError(ctx, err)
 	}
 	res := &pb.Category{
 		Id:          cat.Id,
@@ -124,21 +129,26 @@ func (c *CategoryControllerImpl) UpdateCategory(ctx fiber.Ctx) error {
 }
 
 func (c *CategoryControllerImpl) DeleteCategory(ctx fiber.Ctx) error {
-	var req *pb.GetCategoryFilter
-	req.Id = ctx.Query("id")
+	var req pb.GetCategoryFilter
+	req.Id = ctx.Params("id")
 	if req.Id == "" {
 		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{"error": "id not provided"})
 	}
-	err := ctx.Bind().Body(&req)
+	cat, err := c.categoryService.DeleteCategory(ctx.Context(), &req)
 	if err != nil {
-		return fiber.ErrBadRequest
-	}
-	cat, err := c.categoryService.DeleteCategory(ctx.Context(), req)
-	if err != nil {
-		return fiber.ErrInternalServerError
+		return categoryError(ctx, err)
 	}
 	res := &pb.DeleteCategoryResponse{
 		Status: cat.Status,
 	}
 	return ctx.Status(fiber.StatusOK).JSON(res)
 }
+
+// categoryError writes the status and message of a category service error.
+func categoryError(ctx fiber.Ctx, err error) error {
+	var fe *fiber.Error
+	if errors.As(err, &fe) {
+		return ctx.Status(fe.Code).JSON(fiber.Map{"error": fe.Message})
+	}
+	return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{"error": "internal server error"})
+}
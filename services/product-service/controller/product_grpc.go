@@ -0,0 +1,174 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	"github.com/daffaromero/retries/services/product-service/service"
+	"github.com/gofiber/fiber/v3"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type productGRPCServer struct {
+	pb.UnimplementedProductServiceServer
+	productService  service.ProductService
+	categoryService service.CategoryService
+}
+
+func NewProductGRPCServer(prodServ service.ProductService, catServ service.CategoryService) pb.ProductServiceServer {
+	return &productGRPCServer{
+		productService:  prodServ,
+		categoryService: catServ,
+	}
+}
+
+func (p *productGRPCServer) CreateProduct(ctx context.Context, req *pb.Product) (*pb.Product, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name not provided")
+	}
+	res, err := p.productService.CreateProduct(ctx, req, req.SellerId, req.SellerName)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
+
+func (p *productGRPCServer) GetProductByID(ctx context.Context, req *pb.GetProductFilter) (*pb.GetProductResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id not provided")
+	}
+	res, err := p.productService.GetProductByID(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if len(res.Products) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no product found with ID %s", req.Id)
+	}
+	return res, nil
+}
+
+func (p *productGRPCServer) GetProducts(ctx context.Context, req *pb.GetProductFilter) (*pb.GetProductResponse, error) {
+	res, err := p.productService.GetAllProducts(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
+
+func (p *productGRPCServer) SearchProducts(ctx context.Context, req *pb.GetProductFilter) (*pb.SearchProductsResponse, error) {
+	if req.Search == "" {
+		return nil, status.Error(codes.InvalidArgument, "search not provided")
+	}
+	res, err := p.productService.SearchProducts(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
+
+func (p *productGRPCServer) UpdateProduct(ctx context.Context, req *pb.Product) (*pb.Product, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id not provided")
+	}
+	res, err := p.productService.UpdateProduct(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
+
+func (p *productGRPCServer) ApproveProduct(ctx context.Context, req *pb.ApproveProductRequest) (*pb.ApproveProductResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id not provided")
+	}
+	res, err := p.productService.ApproveProduct(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
+
+func (p *productGRPCServer) CreateCategory(ctx context.Context, req *pb.Category) (*pb.Category, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name not provided")
+	}
+	res, err := p.categoryService.CreateCategory(ctx, req, req.Name, req.Description)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
+
+func (p *productGRPCServer) GetCategoryByID(ctx context.Context, req *pb.GetCategoryFilter) (*pb.GetCategoryResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id not provided")
+	}
+	res, err := p.categoryService.GetCategoryByID(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	if len(res.Categories) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no category found with ID %s", req.Id)
+	}
+	return res, nil
+}
+
+func (p *productGRPCServer) GetCategories(ctx context.Context, req *pb.GetCategoryFilter) (*pb.GetCategoryResponse, error) {
+	res, err := p.categoryService.GetCategories(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
+
+func (p *productGRPCServer) UpdateCategory(ctx context.Context, req *pb.Category) (*pb.Category, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id not provided")
+	}
+	res, err := p.categoryService.UpdateCategory(ctx, req, req.Name, req.Description)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
+
+func (p *productGRPCServer) DeleteCategory(ctx context.Context, req *pb.GetCategoryFilter) (*pb.DeleteCategoryResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id not provided")
+	}
+	res, err := p.categoryService.DeleteCategory(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
+
+// toStatus maps the fiber errors of the product and category services onto
+// the matching gRPC codes, and a product or category that does not exist onto
+// NotFound. Anything else is logged and reported without its details.
+func toStatus(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return status.Error(codes.NotFound, "no records found")
+	}
+	var fe *fiber.Error
+	if !errors.As(err, &fe) {
+		log.Printf("Unexpected product service error: %v", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+	switch fe.Code {
+	case fiber.StatusBadRequest:
+		return status.Error(codes.InvalidArgument, fe.Message)
+	case fiber.StatusNotFound:
+		return status.Error(codes.NotFound, fe.Message)
+	case fiber.StatusForbidden:
+		return status.Error(codes.PermissionDenied, fe.Message)
+	case fiber.StatusConflict:
+		return status.Error(codes.AlreadyExists, fe.Message)
+	default:
+		return status.Error(codes.Internal, fe.Message)
+	}
+}
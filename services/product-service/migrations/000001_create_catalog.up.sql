@@ -0,0 +1,40 @@
+CREATE TABLE categories (
+    id VARCHAR PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    description VARCHAR NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+
+CREATE TABLE products (
+    id VARCHAR PRIMARY KEY,
+    seller_id VARCHAR NOT NULL,
+    category_id VARCHAR NOT NULL DEFAULT '',
+    category_name VARCHAR NOT NULL DEFAULT '',
+    variant_ids TEXT[] NOT NULL DEFAULT '{}',
+    name VARCHAR NOT NULL,
+    seller_name VARCHAR NOT NULL DEFAULT '',
+    description VARCHAR NOT NULL DEFAULT '',
+    vis_time TIMESTAMP,
+    invis_time TIMESTAMP,
+    insider_key VARCHAR NOT NULL DEFAULT '',
+    voucher VARCHAR NOT NULL DEFAULT '',
+    voucher_discount INT NOT NULL DEFAULT 0,
+    total_duration INT NOT NULL DEFAULT 0,
+    variant_settings JSONB NOT NULL DEFAULT '[]',
+    is_reviewable BOOLEAN NOT NULL DEFAULT FALSE,
+    is_admin_verified VARCHAR NOT NULL DEFAULT '',
+    visibility VARCHAR NOT NULL DEFAULT '',
+    exclusion VARCHAR NOT NULL DEFAULT '',
+    price INT NOT NULL DEFAULT 0,
+    pict_url VARCHAR NOT NULL DEFAULT '',
+    cert_url VARCHAR NOT NULL DEFAULT '',
+    flat_price INT NOT NULL DEFAULT 0,
+    percentage_price INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+CREATE INDEX products_seller_id_idx ON products (seller_id);
+CREATE INDEX products_category_name_idx ON products (category_name);
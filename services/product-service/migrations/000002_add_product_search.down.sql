@@ -0,0 +1,2 @@
+DROP INDEX products_search_vector_idx;
+ALTER TABLE products DROP COLUMN search_vector;
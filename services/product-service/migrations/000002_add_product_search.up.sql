@@ -0,0 +1,7 @@
+ALTER TABLE products ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', COALESCE(name, '')), 'A') ||
+    setweight(to_tsvector('english', COALESCE(category_name, '')), 'B') ||
+    setweight(to_tsvector('english', COALESCE(seller_name, '')), 'B') ||
+    setweight(to_tsvector('english', COALESCE(description, '')), 'C')
+) STORED;
+CREATE INDEX products_search_vector_idx ON products USING GIN (search_vector);
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	"github.com/daffaromero/retries/services/common/store"
 	"github.com/daffaromero/retries/services/product-service/repository/query"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -18,11 +19,11 @@ type CategoryRepository interface {
 }
 
 type categoryRepository struct {
-	db       Store
+	db       store.Store
 	catQuery query.CategoryQuery
 }
 
-func NewCategoryRepository(db Store, catQuery query.CategoryQuery) CategoryRepository {
+func NewCategoryRepository(db store.Store, catQuery query.CategoryQuery) CategoryRepository {
 	return &categoryRepository{db: db, catQuery: catQuery}
 }
 
@@ -61,11 +62,12 @@ func (c *categoryRepository) GetCategoryByID(ctx context.Context, req *pb.GetCat
 func (c *categoryRepository) GetCategories(ctx context.Context, req *pb.GetCategoryFilter) (*pb.GetCategoryResponse, error) {
 	var categories *pb.GetCategoryResponse
 
-	err := c.db.WithoutTx(ctx, func(pool *pgxpool.Pool) error {
+	// The page and its total are read from one snapshot.
+	err := c.db.WithTx(ctx, func(tx pgx.Tx) error {
 		var err error
-		categories, err = c.catQuery.GetCategories(ctx, req)
+		categories, err = c.catQuery.GetCategories(ctx, tx, req)
 		return err
-	})
+	}, store.Isolation(pgx.RepeatableRead), store.ReadOnly())
 	if err != nil {
 		return nil, err
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	"github.com/daffaromero/retries/services/common/store"
 	"github.com/daffaromero/retries/services/product-service/repository/query"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -13,16 +14,17 @@ type ProductRepository interface {
 	CreateProduct(c context.Context, product *pb.Product) (*pb.Product, error)
 	GetProductByID(c context.Context, filter *pb.GetProductFilter) (*pb.GetProductResponse, error)
 	GetAllProducts(c context.Context, filter *pb.GetProductFilter) (*pb.GetProductResponse, error)
+	SearchProducts(c context.Context, filter *pb.GetProductFilter) (*pb.SearchProductsResponse, error)
 	UpdateProduct(c context.Context, product *pb.Product) (*pb.Product, error)
 	ApproveProduct(c context.Context, approve *pb.ApproveProductRequest) (*pb.ApproveProductResponse, error)
 }
 
 type productRepository struct {
-	db           Store
+	db           store.Store
 	productQuery query.ProductQuery
 }
 
-func NewProductRepository(db Store, productQuery query.ProductQuery) ProductRepository {
+func NewProductRepository(db store.Store, productQuery query.ProductQuery) ProductRepository {
 	return &productRepository{db: db, productQuery: productQuery}
 }
 
@@ -60,14 +62,32 @@ func (p *productRepository) GetProductByID(c context.Context, filter *pb.GetProd
 
 func (p *productRepository) GetAllProducts(c context.Context, filter *pb.GetProductFilter) (*pb.GetProductResponse, error) {
 	var res *pb.GetProductResponse
-	err := p.db.WithoutTx(c, func(pool *pgxpool.Pool) error {
-		prod, err := p.productQuery.GetProducts(c, filter)
+	// A repeatable read transaction gives the page and its total one snapshot.
+	err := p.db.WithTx(c, func(tx pgx.Tx) error {
+		prod, err := p.productQuery.GetProducts(c, tx, filter)
 		if err != nil {
 			return err
 		}
 		res = prod
 		return nil
-	})
+	}, store.Isolation(pgx.RepeatableRead), store.ReadOnly())
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (p *productRepository) SearchProducts(c context.Context, filter *pb.GetProductFilter) (*pb.SearchProductsResponse, error) {
+	var res *pb.SearchProductsResponse
+	// Hits, total and facets come from one snapshot, so the counts agree.
+	err := p.db.WithTx(c, func(tx pgx.Tx) error {
+		hits, err := p.productQuery.SearchProducts(c, tx, filter)
+		if err != nil {
+			return err
+		}
+		res = hits
+		return nil
+	}, store.Isolation(pgx.RepeatableRead), store.ReadOnly())
 	if err != nil {
 		return nil, err
 	}
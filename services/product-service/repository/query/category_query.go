@@ -7,14 +7,16 @@ import (
 	"time"
 
 	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	"github.com/daffaromero/retries/services/common/sqlbuilder"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type CategoryQuery interface {
 	CreateCategory(c context.Context, tx pgx.Tx, req *pb.Category) (*pb.Category, error)
 	GetCategoryByID(c context.Context, req *pb.GetCategoryFilter) (*pb.GetCategoryResponse, error)
-	GetCategories(c context.Context, req *pb.GetCategoryFilter) (*pb.GetCategoryResponse, error)
+	GetCategories(c context.Context, tx pgx.Tx, req *pb.GetCategoryFilter) (*pb.GetCategoryResponse, error)
 	UpdateCategory(c context.Context, tx pgx.Tx, req *pb.Category) (*pb.Category, error)
 	DeleteCategory(c context.Context, tx pgx.Tx, req *pb.GetCategoryFilter) (*pb.DeleteCategoryResponse, error)
 }
@@ -30,8 +32,8 @@ func NewCategoryQueryImpl(db *pgxpool.Pool) *CategoryQueryImpl {
 }
 
 func (c *CategoryQueryImpl) CreateCategory(ctx context.Context, tx pgx.Tx, req *pb.Category) (*pb.Category, error) {
-	query := `INSERT INTO categories (id, name, description, created_at, updated_at) VALUES ($1, $2, $3, $4, $5)`
-	err := tx.QueryRow(ctx, query, req.Id, req.Name, req.Description, req.CreatedAt, req.UpdatedAt).Scan(&req.Id)
+	query := `INSERT INTO categories (id, name, description, created_at, updated_at) VALUES ($1, $2, $3, $4, $5) RETURNING id`
+	err := tx.QueryRow(ctx, query, req.Id, req.Name, req.Description, req.CreatedAt.AsTime(), req.UpdatedAt.AsTime()).Scan(&req.Id)
 	if err != nil {
 		log.Println(err)
 		return nil, err
@@ -39,56 +41,59 @@ func (c *CategoryQueryImpl) CreateCategory(ctx context.Context, tx pgx.Tx, req *
 	return &pb.Category{Id: req.Id, Name: req.Name, Description: req.Description}, nil
 }
 
+// GetCategoryByID returns pgx.ErrNoRows when no category has the ID.
 func (c *CategoryQueryImpl) GetCategoryByID(ctx context.Context, req *pb.GetCategoryFilter) (*pb.GetCategoryResponse, error) {
-	query := `SELECT id, name, description FROM categories WHERE id=$1`
-	rows, err := c.db.Query(ctx, query, req.Id)
-	if err == pgx.ErrNoRows {
-		return nil, fmt.Errorf("no records found")
-	} else if err != nil {
+	query := `SELECT id, name, description FROM categories WHERE id = $1`
+	var category pb.Category
+	if err := c.db.QueryRow(ctx, query, req.Id).Scan(&category.Id, &category.Name, &category.Description); err != nil {
 		return nil, err
 	}
-	var categories []*pb.Category
-	for rows.Next() {
-		var category pb.Category
-		err = rows.Scan(&category.Id, &category.Name, &category.Description)
-		if err != nil {
-			return nil, err
-		}
-		categoryWithId := &pb.Category{
-			Id:          category.Id,
-			Name:        category.Name,
-			Description: category.Description,
-		}
-		categories = append(categories, categoryWithId)
-	}
-	return &pb.GetCategoryResponse{Categories: categories}, nil
+	return &pb.GetCategoryResponse{Categories: []*pb.Category{&category}}, nil
 }
 
-func (c *CategoryQueryImpl) GetCategories(ctx context.Context, req *pb.GetCategoryFilter) (*pb.GetCategoryResponse, error) {
-	query := `SELECT id, name, description FROM categories LIMIT $1 OFFSET $2`
-	rows, err := c.db.Query(ctx, query, req.Pagination.Limit, req.Pagination.Offset)
+func (c *CategoryQueryImpl) GetCategories(ctx context.Context, tx pgx.Tx, req *pb.GetCategoryFilter) (*pb.GetCategoryResponse, error) {
+	q, err := CategoryFilters(req)
+	if err != nil {
+		return nil, err
+	}
+	query, args := q.Build()
+	rows, err := tx.Query(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("get all query error: %v", err)
+		return nil, fmt.Errorf("get all query error: %w", err)
 	}
 	defer rows.Close()
 
 	var categories []*pb.Category
 	for rows.Next() {
-		var category pb.Category
-		if err := rows.Scan(&category.Id, &category.Name, &category.Description); err != nil {
+		var (
+			category             pb.Category
+			createdAt, updatedAt time.Time
+		)
+		if err := rows.Scan(&category.Id, &category.Name, &category.Description, &createdAt, &updatedAt); err != nil {
 			return nil, err
 		}
+		category.CreatedAt = timestamppb.New(createdAt)
+		category.UpdatedAt = timestamppb.New(updatedAt)
 		categories = append(categories, &category)
-		if err != nil {
-			return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	res := &pb.GetCategoryResponse{}
+	res.Categories, res.NextCursor = sqlbuilder.Next(q, categories, categoryKey)
+	if req.Pagination.GetWithTotal() {
+		query, args := q.Count()
+		if err := tx.QueryRow(ctx, query, args...).Scan(&res.Total); err != nil {
+			return nil, fmt.Errorf("count query error: %w", err)
 		}
 	}
-	return &pb.GetCategoryResponse{Categories: categories}, nil
+	return res, nil
 }
 
 func (c *CategoryQueryImpl) UpdateCategory(ctx context.Context, tx pgx.Tx, req *pb.Category) (*pb.Category, error) {
-	query := `UPDATE categories SET name $1 description $2, updated_at $3 WHERE id = $4`
-	err := tx.QueryRow(ctx, query, req.Id, req.Name, req.Description, req.UpdatedAt).Scan(&req.Id)
+	query := `UPDATE categories SET name = $1, description = $2, updated_at = $3 WHERE id = $4 AND deleted_at IS NULL RETURNING id`
+	err := tx.QueryRow(ctx, query, req.Name, req.Description, req.UpdatedAt.AsTime(), req.Id).Scan(&req.Id)
 	if err != nil {
 		log.Println(err)
 		return nil, err
@@ -98,9 +103,9 @@ func (c *CategoryQueryImpl) UpdateCategory(ctx context.Context, tx pgx.Tx, req *
 
 func (c *CategoryQueryImpl) DeleteCategory(ctx context.Context, tx pgx.Tx, req *pb.GetCategoryFilter) (*pb.DeleteCategoryResponse, error) {
 	query := `UPDATE categories SET deleted_at = $1 WHERE id = $2`
-	rows, err := tx.Query(ctx, query, time.Now(), req.Id)
+	rows, err := tx.Query(ctx, query, time.Now().UTC(), req.Id)
 	if err != nil {
-		return nil, fmt.Errorf("delete query error: %v", err)
+		return nil, fmt.Errorf("delete query error: %w", err)
 	}
 	defer rows.Close()
 
@@ -4,20 +4,65 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	"github.com/daffaromero/retries/services/common/sqlbuilder"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type ProductQuery interface {
 	CreateProduct(context.Context, pgx.Tx, *pb.Product) (*pb.Product, error)
 	GetProductByID(context.Context, *pb.GetProductFilter) (*pb.GetProductResponse, error)
-	GetProducts(context.Context, *pb.GetProductFilter) (*pb.GetProductResponse, error)
+	GetProducts(context.Context, pgx.Tx, *pb.GetProductFilter) (*pb.GetProductResponse, error)
+	SearchProducts(context.Context, pgx.Tx, *pb.GetProductFilter) (*pb.SearchProductsResponse, error)
 	UpdateProduct(context.Context, pgx.Tx, *pb.Product) (*pb.Product, error)
 	ApproveProduct(context.Context, pgx.Tx, *pb.ApproveProductRequest) (*pb.ApproveProductResponse, error)
 }
 
+// scanProduct reads a row selected with productColumns, followed by any
+// extra columns into extra.
+func scanProduct(row pgx.Row, product *pb.Product, extra ...any) error {
+	var (
+		varSettings          []byte
+		visTime, invisTime   *time.Time
+		createdAt, updatedAt time.Time
+	)
+	dest := []any{&product.Id, &product.SellerId, &product.CategoryId, &product.CategoryName, &product.VariantIds, &product.Name, &product.SellerName, &product.Description, &visTime, &invisTime, &product.InsiderKey, &product.Voucher, &product.VoucherDiscount, &product.TotalDuration, &varSettings, &product.IsReviewable, &product.IsAdminVerified, &product.Visibility, &product.Exclusion, &product.Price, &product.PictUrl, &product.CertUrl, &product.FlatPrice, &product.PercentagePrice, &createdAt, &updatedAt}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return fmt.Errorf("scan product error: %w", err)
+	}
+	product.VisTime = optionalTimestamp(visTime)
+	product.InvisTime = optionalTimestamp(invisTime)
+	product.CreatedAt = timestamppb.New(createdAt)
+	product.UpdatedAt = timestamppb.New(updatedAt)
+	if err := json.Unmarshal(varSettings, &product.VariantSettings); err != nil {
+		return fmt.Errorf("variant settings error: %w", err)
+	}
+	return nil
+}
+
+// optionalTimestamp converts a nullable TIMESTAMP column, read as UTC, to its
+// proto form; NULL stays nil.
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// optionalTime is the value written to a nullable TIMESTAMP column for ts:
+// its UTC time, or NULL when ts is nil.
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
 type ProductQueryImpl struct {
 	db *pgxpool.Pool
 }
@@ -27,119 +72,76 @@ func NewProductQueryImpl(db *pgxpool.Pool) *ProductQueryImpl {
 }
 
 func (p *ProductQueryImpl) CreateProduct(c context.Context, tx pgx.Tx, req *pb.Product) (*pb.Product, error) {
-	query := `INSERT INTO products (id, seller_id, category_id, category_name, variant_ids, name, seller_name, description, vis_time, invis_time, insider_key, voucher, voucher_discount, total_duration, variant_settings, is_reviewable, is_admin_verified, visibility, exclusion, price, pict_url, cert_url, flat_price, percentage_price, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27)`
-	var variantSettings pb.VariantSettings
+	query := `INSERT INTO products (id, seller_id, category_id, category_name, variant_ids, name, seller_name, description, vis_time, invis_time, insider_key, voucher, voucher_discount, total_duration, variant_settings, is_reviewable, is_admin_verified, visibility, exclusion, price, pict_url, cert_url, flat_price, percentage_price, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26) RETURNING id`
 	varSettings, err := json.Marshal(req.VariantSettings)
 	if err != nil {
 		return nil, err
 	}
-	err = tx.QueryRow(c, query, req.Id, req.SellerId, req.CategoryId, req.CategoryName, req.VariantIds, req.Name, req.SellerName, req.Description, req.VisTime, req.InvisTime, req.InsiderKey, req.Voucher, req.VoucherDiscount, req.TotalDuration, string(varSettings), req.IsReviewable, req.IsAdminVerified, req.Visibility, req.Exclusion, req.Price, req.PictUrl, req.CertUrl, req.FlatPrice, req.PercentagePrice, req.CreatedAt, req.UpdatedAt).Scan(&req.Id)
+	err = tx.QueryRow(c, query, req.Id, req.SellerId, req.CategoryId, req.CategoryName, req.VariantIds, req.Name, req.SellerName, req.Description, optionalTime(req.VisTime), optionalTime(req.InvisTime), req.InsiderKey, req.Voucher, req.VoucherDiscount, req.TotalDuration, string(varSettings), req.IsReviewable, req.IsAdminVerified, req.Visibility, req.Exclusion, req.Price, req.PictUrl, req.CertUrl, req.FlatPrice, req.PercentagePrice, req.CreatedAt.AsTime(), req.UpdatedAt.AsTime()).Scan(&req.Id)
 	if err != nil {
 		return nil, err
 	}
-	err = json.Unmarshal(varSettings, &variantSettings)
-	if err != nil {
-		return nil, err
-	}
-	return &pb.Product{Id: req.Id, SellerId: req.SellerId, CategoryId: req.CategoryId, CategoryName: req.CategoryName, VariantIds: req.VariantIds, Name: req.Name, SellerName: req.SellerName, Description: req.Description, VisTime: req.VisTime, InsiderKey: req.InsiderKey, Voucher: req.Voucher, VoucherDiscount: req.VoucherDiscount, TotalDuration: req.TotalDuration, VariantSettings: []*pb.VariantSettings{&variantSettings}, IsReviewable: req.IsReviewable, IsAdminVerified: req.IsAdminVerified, Visibility: req.Visibility, Exclusion: req.Exclusion, Price: req.Price, PictUrl: req.PictUrl, CertUrl: req.CertUrl, FlatPrice: req.FlatPrice, PercentagePrice: req.PercentagePrice, CreatedAt: req.CreatedAt, UpdatedAt: req.UpdatedAt}, nil
+	return &pb.Product{Id: req.Id, SellerId: req.SellerId, CategoryId: req.CategoryId, CategoryName: req.CategoryName, VariantIds: req.VariantIds, Name: req.Name, SellerName: req.SellerName, Description: req.Description, VisTime: req.VisTime, InvisTime: req.InvisTime, InsiderKey: req.InsiderKey, Voucher: req.Voucher, VoucherDiscount: req.VoucherDiscount, TotalDuration: req.TotalDuration, VariantSettings: req.VariantSettings, IsReviewable: req.IsReviewable, IsAdminVerified: req.IsAdminVerified, Visibility: req.Visibility, Exclusion: req.Exclusion, Price: req.Price, PictUrl: req.PictUrl, CertUrl: req.CertUrl, FlatPrice: req.FlatPrice, PercentagePrice: req.PercentagePrice, CreatedAt: req.CreatedAt, UpdatedAt: req.UpdatedAt}, nil
 }
 
+// GetProductByID returns pgx.ErrNoRows, wrapped, when no live product has
+// the ID.
 func (p *ProductQueryImpl) GetProductByID(c context.Context, req *pb.GetProductFilter) (*pb.GetProductResponse, error) {
-	query := `SELECT id, seller_id, category_id, category_name, variant_ids, name, seller_name, description, vis_time, invis_time, insider_key, voucher, voucher_discount, total_duration, variant_settings, is_reviewable, is_admin_verified, visibility, exclusion, price, pict_url, cert_url, flat_price, percentage_price, created_at, updated_at FROM products WHERE id = $1 AND deleted_at IS NULL`
-	var productWithId []*pb.Product
-	var variantSettings pb.VariantSettings
-	rows, err := p.db.Query(c, query, req.Id)
-	if err == pgx.ErrNoRows {
-		return nil, fmt.Errorf("no records found")
-	} else if err != nil {
+	query := `SELECT ` + productColumns + ` FROM products WHERE id = $1 AND deleted_at IS NULL`
+	var product pb.Product
+	if err := scanProduct(p.db.QueryRow(c, query, req.Id), &product); err != nil {
 		return nil, err
 	}
-	for rows.Next() {
-		var product pb.Product
-		err = rows.Scan(&product.Id, &product.SellerId, &product.CategoryId, &product.CategoryName, &product.VariantIds, &product.Name, &product.SellerName, &product.Description, &product.VisTime, &product.InvisTime, &product.InsiderKey, &product.Voucher, &product.VoucherDiscount, &product.TotalDuration, []*pb.VariantSettings{&variantSettings}, &product.IsReviewable, &product.IsAdminVerified, &product.Visibility, &product.Exclusion, &product.Price, &product.PictUrl, &product.CertUrl, &product.FlatPrice, &product.PercentagePrice, &product.CreatedAt, &product.UpdatedAt)
-		if err != nil {
-			return nil, err
-		}
-		productStruct := &pb.Product{
-			Id:              product.Id,
-			SellerId:        product.SellerId,
-			CategoryId:      product.CategoryId,
-			CategoryName:    product.CategoryName,
-			VariantIds:      product.VariantIds,
-			Name:            product.Name,
-			SellerName:      product.SellerName,
-			Description:     product.Description,
-			VisTime:         product.VisTime,
-			InvisTime:       product.InvisTime,
-			InsiderKey:      product.InsiderKey,
-			Voucher:         product.Voucher,
-			VoucherDiscount: product.VoucherDiscount,
-			TotalDuration:   product.TotalDuration,
-			VariantSettings: product.VariantSettings,
-			IsReviewable:    product.IsReviewable,
-			IsAdminVerified: product.IsAdminVerified,
-			Visibility:      product.Visibility,
-			Exclusion:       product.Exclusion,
-			Price:           product.Price,
-			PictUrl:         product.PictUrl,
-			CertUrl:         product.CertUrl,
-			FlatPrice:       product.FlatPrice,
-			PercentagePrice: product.PercentagePrice,
-			CreatedAt:       product.CreatedAt,
-			UpdatedAt:       product.UpdatedAt,
-		}
-		productWithId = append(productWithId, productStruct)
-	}
-	return &pb.GetProductResponse{Products: productWithId}, nil
+	return &pb.GetProductResponse{Products: []*pb.Product{&product}}, nil
 }
 
-func (p *ProductQueryImpl) GetProducts(c context.Context, req *pb.GetProductFilter) (*pb.GetProductResponse, error) {
-	filter, page, sort, earliest, latest, err := ProductFilters("user", req)
+func (p *ProductQueryImpl) GetProducts(c context.Context, tx pgx.Tx, req *pb.GetProductFilter) (*pb.GetProductResponse, error) {
+	q, err := ProductFilters("user", req)
 	if err != nil {
-		return nil, fmt.Errorf("error building product filters, %v", err)
+		return nil, err
 	}
-	query := fmt.Sprintf(`SELECT id, seller_id, category_id, category_name, variant_ids, name, seller_name, description, vis_time, invis_time, insider_key, voucher, voucher_discount, total_duration, variant_settings, is_reviewable, is_admin_verified, visibility, exclusion, price, pict_url, cert_url, flat_price, percentage_price, created_at, updated_at FROM products WHERE deleted_at IS NULL %s %s %s`, filter, sort, page)
-	rows, err := p.db.Query(c, query, earliest, latest)
-	if err == pgx.ErrNoRows {
-		return nil, fmt.Errorf("no records found")
-	} else if err != nil {
+	query, args := q.Build()
+	rows, err := tx.Query(c, query, args...)
+	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var variantSettings pb.VariantSettings
 	var products []*pb.Product
 
 	for rows.Next() {
 		var product pb.Product
-
-		if err := rows.Scan(&product.Id, &product.SellerId, &product.CategoryId, &product.CategoryName, &product.VariantIds, &product.Name, &product.SellerName, &product.Description, &product.VisTime, &product.InvisTime, &product.InsiderKey, &product.Voucher, &product.VoucherDiscount, &product.TotalDuration, []*pb.VariantSettings{&variantSettings}, &product.IsReviewable, &product.IsAdminVerified, &product.Visibility, &product.Exclusion, &product.Price, &product.PictUrl, &product.CertUrl, &product.FlatPrice, &product.PercentagePrice, &product.CreatedAt, &product.UpdatedAt); err != nil {
-			return nil, fmt.Errorf("scan all products error: %v", err)
+		if err := scanProduct(rows, &product); err != nil {
+			return nil, err
 		}
 		products = append(products, &product)
 	}
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("rows error: %v", err)
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	res := &pb.GetProductResponse{}
+	res.Products, res.NextCursor = sqlbuilder.Next(q, products, productKey)
+	if req.Pagination.GetWithTotal() {
+		query, args := q.Count()
+		if err := tx.QueryRow(c, query, args...).Scan(&res.Total); err != nil {
+			return nil, fmt.Errorf("count query error: %w", err)
+		}
 	}
-	return &pb.GetProductResponse{Products: products}, nil
+	return res, nil
 }
 
 func (p *ProductQueryImpl) UpdateProduct(c context.Context, tx pgx.Tx, req *pb.Product) (*pb.Product, error) {
 	query := `UPDATE products SET seller_id = $1, category_id = $2, category_name = $3, variant_ids = $4, name = $5, seller_name = $6, description = $7, vis_time = $8, invis_time = $9, insider_key = $10, voucher = $11, voucher_discount = $12, total_duration = $13, variant_settings = $14, is_reviewable = $15, is_admin_verified = $16, visibility = $17, exclusion = $18, price = $19, pict_url = $20, cert_url = $21, flat_price = $22, percentage_price = $23, updated_at = $24 WHERE id = $25 AND deleted_at IS NULL`
-	var variantSettings pb.VariantSettings
 	varSettings, err := json.Marshal(req.VariantSettings)
 	if err != nil {
 		return nil, err
 	}
-	_, err = tx.Exec(c, query, req.SellerId, req.CategoryId, req.CategoryName, req.VariantIds, req.Name, req.SellerName, req.Description, req.VisTime, req.InvisTime, req.InsiderKey, req.Voucher, req.VoucherDiscount, req.TotalDuration, string(varSettings), req.IsReviewable, req.IsAdminVerified, req.Visibility, req.Exclusion, req.Price, req.PictUrl, req.CertUrl, req.FlatPrice, req.PercentagePrice, req.UpdatedAt, req.Id)
-	if err != nil {
-		return nil, err
-	}
-	err = json.Unmarshal(varSettings, &variantSettings)
+	_, err = tx.Exec(c, query, req.SellerId, req.CategoryId, req.CategoryName, req.VariantIds, req.Name, req.SellerName, req.Description, optionalTime(req.VisTime), optionalTime(req.InvisTime), req.InsiderKey, req.Voucher, req.VoucherDiscount, req.TotalDuration, string(varSettings), req.IsReviewable, req.IsAdminVerified, req.Visibility, req.Exclusion, req.Price, req.PictUrl, req.CertUrl, req.FlatPrice, req.PercentagePrice, req.UpdatedAt.AsTime(), req.Id)
 	if err != nil {
 		return nil, err
 	}
-	return &pb.Product{Id: req.Id, SellerId: req.SellerId, CategoryId: req.CategoryId, CategoryName: req.CategoryName, VariantIds: req.VariantIds, Name: req.Name, SellerName: req.SellerName, Description: req.Description, VisTime: req.VisTime, InsiderKey: req.InsiderKey, Voucher: req.Voucher, VoucherDiscount: req.VoucherDiscount, TotalDuration: req.TotalDuration, VariantSettings: []*pb.VariantSettings{&variantSettings}, IsReviewable: req.IsReviewable, IsAdminVerified: req.IsAdminVerified, Visibility: req.Visibility, Exclusion: req.Exclusion, Price: req.Price, PictUrl: req.PictUrl, CertUrl: req.CertUrl, FlatPrice: req.FlatPrice, PercentagePrice: req.PercentagePrice, CreatedAt: req.CreatedAt, UpdatedAt: req.UpdatedAt}, nil
+	return &pb.Product{Id: req.Id, SellerId: req.SellerId, CategoryId: req.CategoryId, CategoryName: req.CategoryName, VariantIds: req.VariantIds, Name: req.Name, SellerName: req.SellerName, Description: req.Description, VisTime: req.VisTime, InvisTime: req.InvisTime, InsiderKey: req.InsiderKey, Voucher: req.Voucher, VoucherDiscount: req.VoucherDiscount, TotalDuration: req.TotalDuration, VariantSettings: req.VariantSettings, IsReviewable: req.IsReviewable, IsAdminVerified: req.IsAdminVerified, Visibility: req.Visibility, Exclusion: req.Exclusion, Price: req.Price, PictUrl: req.PictUrl, CertUrl: req.CertUrl, FlatPrice: req.FlatPrice, PercentagePrice: req.PercentagePrice, CreatedAt: req.CreatedAt, UpdatedAt: req.UpdatedAt}, nil
 }
 
 func (p *ProductQueryImpl) ApproveProduct(c context.Context, tx pgx.Tx, req *pb.ApproveProductRequest) (*pb.ApproveProductResponse, error) {
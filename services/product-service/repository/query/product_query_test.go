@@ -0,0 +1,111 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	"github.com/daffaromero/retries/services/common/migrate/migratetest"
+	"github.com/daffaromero/retries/services/product-service/migrations"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// stamp is t as Postgres keeps it.
+func stamp(t time.Time) *timestamppb.Timestamp {
+	return timestamppb.New(t.Truncate(time.Microsecond))
+}
+
+// inTx runs fn in a transaction that is committed when fn succeeds.
+func inTx(t *testing.T, db *pgxpool.Pool, fn func(ctx context.Context, tx pgx.Tx) error) {
+	t.Helper()
+	ctx := context.Background()
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tx.Rollback(ctx)
+	if err := fn(ctx, tx); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProductRoundTrip(t *testing.T) {
+	db := migratetest.DB(t, "product-service", migrations.FS)
+	q := NewProductQueryImpl(db)
+	ctx := context.Background()
+
+	now := time.Now()
+	product := &pb.Product{
+		Id:              "product-1",
+		SellerId:        "seller-1",
+		CategoryName:    "books",
+		VariantIds:      []string{},
+		Name:            "Chess openings",
+		VisTime:         stamp(now.Add(-time.Hour)),
+		VariantSettings: []*pb.VariantSettings{},
+		Price:           100,
+		CreatedAt:       stamp(now),
+		UpdatedAt:       stamp(now),
+	}
+	inTx(t, db, func(ctx context.Context, tx pgx.Tx) error {
+		_, err := q.CreateProduct(ctx, tx, product)
+		return err
+	})
+
+	res, err := q.GetProductByID(ctx, &pb.GetProductFilter{Id: "product-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := res.Products[0]
+	if got.InvisTime != nil {
+		t.Errorf("invis_time = %v, want NULL read as nil", got.InvisTime)
+	}
+	if !proto.Equal(got, product) {
+		t.Errorf("GetProductByID = %v, want %v", got, product)
+	}
+
+	updated := proto.Clone(product).(*pb.Product)
+	updated.InvisTime = stamp(now.Add(time.Hour))
+	updated.UpdatedAt = stamp(now.Add(time.Minute))
+	inTx(t, db, func(ctx context.Context, tx pgx.Tx) error {
+		_, err := q.UpdateProduct(ctx, tx, updated)
+		return err
+	})
+
+	var list *pb.GetProductResponse
+	inTx(t, db, func(ctx context.Context, tx pgx.Tx) (err error) {
+		list, err = q.GetProducts(ctx, tx, &pb.GetProductFilter{Sorting: &pb.Sorting{OrderBy: "updated_at"}})
+		return err
+	})
+	if len(list.Products) != 1 || !proto.Equal(list.Products[0], updated) {
+		t.Errorf("GetProducts = %v, want %v", list.Products, updated)
+	}
+}
+
+func TestCategoryRoundTrip(t *testing.T) {
+	db := migratetest.DB(t, "product-service", migrations.FS)
+	q := NewCategoryQueryImpl(db)
+
+	now := time.Now()
+	category := &pb.Category{Id: "category-1", Name: "books", Description: "Printed", CreatedAt: stamp(now), UpdatedAt: stamp(now)}
+	inTx(t, db, func(ctx context.Context, tx pgx.Tx) error {
+		_, err := q.CreateCategory(ctx, tx, category)
+		return err
+	})
+
+	var res *pb.GetCategoryResponse
+	inTx(t, db, func(ctx context.Context, tx pgx.Tx) (err error) {
+		res, err = q.GetCategories(ctx, tx, &pb.GetCategoryFilter{})
+		return err
+	})
+	if len(res.Categories) != 1 || !proto.Equal(res.Categories[0], category) {
+		t.Errorf("GetCategories = %v, want %v", res.Categories, category)
+	}
+}
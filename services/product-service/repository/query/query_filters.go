@@ -2,80 +2,260 @@ package query
 
 import (
 	"fmt"
-	"math"
 	"strconv"
+	"strings"
 	"time"
 
 	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
-	"google.golang.org/protobuf/types/known/timestamppb"
+	"github.com/daffaromero/retries/services/common/sqlbuilder"
+	"github.com/daffaromero/retries/services/product-service/search"
+	"google.golang.org/protobuf/proto"
 )
 
-func ProductFilters(mode string, req *pb.GetProductFilter) (filter, pagination, sorting string, earliest, latest *timestamppb.Timestamp, err error) {
-	if req.VisEarly == "" {
-		earliest = timestamppb.New(time.Unix(0, 0))
-	} else {
-		parsedTime, err := time.Parse(time.DateOnly, req.VisEarly)
-		if err != nil {
-			return "", "", "", nil, nil, fmt.Errorf("failed to parse VisEarly: %v", err)
+const productColumns = `id, seller_id, category_id, category_name, variant_ids, name, seller_name, description, vis_time, invis_time, insider_key, voucher, voucher_discount, total_duration, variant_settings, is_reviewable, is_admin_verified, visibility, exclusion, price, pict_url, cert_url, flat_price, percentage_price, created_at, updated_at`
+
+const defaultProductLimit = 10
+
+// productSorts are the keys products can be sorted by.
+var productSorts = map[string]string{
+	"name":          "name",
+	"price":         "price",
+	"category_name": "category_name",
+	"seller_name":   "seller_name",
+	"created_at":    "created_at",
+	"updated_at":    "updated_at",
+}
+
+// searchColumns follow productColumns in search results: the rank of the
+// match, then the name and a description snippet with matches highlighted.
+var searchColumns = productColumns + `, ts_rank_cd(search_vector, query), ts_headline('` + search.Config + `', name, query, '` + search.NameHighlight + `'), ts_headline('` + search.Config + `', COALESCE(description, ''), query, '` + search.DescriptionSnippet + `')`
+
+// searchSorts are the keys search results can be sorted by. Results are
+// sorted by rank unless the request asks otherwise.
+var searchSorts = map[string]string{
+	"rank": "ts_rank_cd(search_vector, query)",
+}
+
+func init() {
+	for key, column := range productSorts {
+		searchSorts[key] = column
+	}
+}
+
+// categorySorts are the keys categories can be sorted by.
+var categorySorts = map[string]string{
+	"name":       "name",
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+}
+
+// ProductFilters builds the product listing query for req. mode is "user",
+// "admin" or "seller"; sellers search product names only.
+func ProductFilters(mode string, req *pb.GetProductFilter) (*sqlbuilder.Query, error) {
+	return productFilters(productColumns, productSorts, mode, req)
+}
+
+// SearchFilters builds the product search query for req, selecting
+// searchColumns, best matches first unless req sorts otherwise. It needs a
+// search with at least one word.
+func SearchFilters(mode string, req *pb.GetProductFilter) (*sqlbuilder.Query, error) {
+	if search.Parse(req.Search, searchWeights(mode)) == "" {
+		return nil, fmt.Errorf("%w: search has no words", sqlbuilder.ErrInvalidFilter)
+	}
+	if req.Sorting.GetOrderBy() == "" {
+		req = proto.Clone(req).(*pb.GetProductFilter)
+		req.Sorting = &pb.Sorting{OrderBy: "rank", IsReversed: true}
+	}
+	return productFilters(searchColumns, searchSorts, mode, req)
+}
+
+// CategoryFacet builds the query counting products that match req by
+// category. It ignores the categories req asks for, so the counts show what
+// choosing another category would give.
+func CategoryFacet(mode string, req *pb.GetProductFilter) (string, []any, error) {
+	req = facetFilter(req)
+	req.Categories = nil
+	q, err := productFilters(productColumns, productSorts, mode, req)
+	if err != nil {
+		return "", nil, err
+	}
+	query, args := q.Aggregate("category_name, count(*)", "GROUP BY category_name ORDER BY count(*) DESC, category_name LIMIT "+strconv.Itoa(search.CategoryFacetLimit))
+	return query, args, nil
+}
+
+// PriceFacet builds the query counting products that match req in each of
+// search.PriceRanges, ignoring the price range req asks for.
+func PriceFacet(mode string, req *pb.GetProductFilter) (string, []any, error) {
+	req = facetFilter(req)
+	req.LowestPrice, req.HighestPrice = 0, 0
+	q, err := productFilters(productColumns, productSorts, mode, req)
+	if err != nil {
+		return "", nil, err
+	}
+	counts := make([]string, 0, len(search.PriceRanges))
+	for _, r := range search.PriceRanges {
+		cond := "price >= " + strconv.Itoa(int(r.Min))
+		if r.Max > 0 {
+			cond += " AND price < " + strconv.Itoa(int(r.Max))
 		}
-		earliest = timestamppb.New(parsedTime)
+		counts = append(counts, "count(*) FILTER (WHERE "+cond+")")
 	}
-	if req.VisLate == "" {
-		latest = timestamppb.New(time.Unix(1<<63-62135596801, 999999999))
+	query, args := q.Aggregate(strings.Join(counts, ", "), "")
+	return query, args, nil
+}
+
+// facetFilter copies req without its sorting and pagination, which facets
+// do not use.
+func facetFilter(req *pb.GetProductFilter) *pb.GetProductFilter {
+	req = proto.Clone(req).(*pb.GetProductFilter)
+	req.Sorting, req.Pagination = nil, nil
+	return req
+}
+
+func productFilters(columns string, sorts map[string]string, mode string, req *pb.GetProductFilter) (*sqlbuilder.Query, error) {
+	var q *sqlbuilder.Query
+	if tsquery := search.Parse(req.Search, searchWeights(mode)); tsquery != "" {
+		q = sqlbuilder.Select(columns, "products, to_tsquery('"+search.Config+"', ?) AS query", "id", tsquery)
+		q.Where("search_vector @@ query")
 	} else {
-		parsedTime, err := time.Parse(time.DateOnly, req.VisLate)
-		if err != nil {
-			return "", "", "", nil, nil, fmt.Errorf("failed to parse VisLate: %v", err)
+		q = sqlbuilder.Select(columns, "products", "id")
+	}
+	q.Where("deleted_at IS NULL")
+
+	for _, r := range []struct {
+		column, field, early, late string
+	}{
+		{"vis_time", "vis", req.VisEarly, req.VisLate},
+		{"invis_time", "invis", req.InvisEarly, req.InvisLate},
+	} {
+		if err := dateRange(q, r.column, r.field, r.early, r.late); err != nil {
+			return nil, err
 		}
-		latest = timestamppb.New(parsedTime)
 	}
 
+	if req.SellerId != "" {
+		q.Eq("seller_id", req.SellerId)
+	}
 	if req.Visibility != "" {
-		filter += " AND visibility = '" + req.Visibility + "'"
+		q.Eq("visibility", req.Visibility)
 	}
-
 	if req.Exclusion != "" {
-		filter += " AND exclusion = '" + req.Exclusion + "'"
+		q.Eq("exclusion", req.Exclusion)
 	}
-
 	if req.IsAdminVerified != "" {
-		filter += " AND is_admin_verified = '" + req.IsAdminVerified + "'"
+		q.Eq("is_admin_verified", req.IsAdminVerified)
 	}
-
-	if req.HighestPrice == 0 {
-		req.HighestPrice = math.MaxInt32
-	} else {
-		filter += " AND price BETWEEN " + strconv.Itoa(int(req.LowestPrice)) + " AND " + strconv.Itoa(int(req.HighestPrice))
+	if req.LowestPrice > 0 {
+		q.Where("price >= ?", req.LowestPrice)
+	}
+	if req.HighestPrice > 0 {
+		q.Where("price <= ?", req.HighestPrice)
 	}
 
 	if len(req.Categories) > 0 {
-		filter += " AND category_name IN ("
+		names := make([]string, 0, len(req.Categories))
 		for _, category := range req.Categories {
-			filter = "'" + category.Name + "', "
+			names = append(names, category.GetName())
 		}
-		filter = filter[:len(filter)-2] + ")"
+		q.Any("category_name", names)
 	}
 
-	if req.Pagination == (&pb.Pagination{}) {
-		req.Pagination = &pb.Pagination{Page: 1, Limit: 10}
+	if err := q.Sort(sorts, req.Sorting.GetOrderBy(), req.Sorting.GetIsReversed()); err != nil {
+		return nil, err
+	}
+	p := req.Pagination
+	q.Paginate(sqlbuilder.Page(p.GetPage(), p.GetLimit(), p.GetOffset(), defaultProductLimit))
+	if err := q.After(p.GetCursor()); err != nil {
+		return nil, err
 	}
-	req.Pagination.Offset = (req.Pagination.Page - 1) * req.Pagination.Limit
-	pagination = "LIMIT " + strconv.Itoa(int(req.Pagination.Limit)) + " OFFSET " + strconv.Itoa(int(req.Pagination.Offset))
 
-	if req.Sorting.OrderBy != "" {
-		sorting = " ORDER BY " + req.Sorting.OrderBy
-		if req.Sorting.IsReversed {
-			sorting += " DESC"
-		} else {
-			sorting += " ASC"
-		}
+	return q, nil
+}
+
+func searchWeights(mode string) search.Weights {
+	if mode == "seller" {
+		return search.NameOnly
+	}
+	return search.AllFields
+}
+
+// CategoryFilters builds the category listing query for req.
+func CategoryFilters(req *pb.GetCategoryFilter) (*sqlbuilder.Query, error) {
+	q := sqlbuilder.Select("id, name, description, created_at, updated_at", "categories", "id")
+	q.Where("deleted_at IS NULL")
+
+	if req.Search != "" {
+		q.Where("name ILIKE ?", sqlbuilder.Contains(req.Search))
 	}
 
-	if req.Search != "" && (mode == "user" || mode == "admin") {
-		filter = " AND name ILIKE '%" + req.Search + "%' OR partner_name ILIKE '%" + req.Search + "%'"
-	} else if req.Search != "" && mode == "seller" {
-		filter = " AND name ILIKE '%" + req.Search
+	if err := q.Sort(categorySorts, req.Sorting.GetOrderBy(), req.Sorting.GetIsReversed()); err != nil {
+		return nil, err
+	}
+	p := req.Pagination
+	q.Paginate(sqlbuilder.Page(p.GetPage(), p.GetLimit(), p.GetOffset(), 0))
+	if err := q.After(p.GetCursor()); err != nil {
+		return nil, err
 	}
 
-	return filter, pagination, sorting, earliest, latest, nil
+	return q, nil
+}
+
+// productKey returns the value of p that sort orders by, and its id.
+func productKey(p *pb.Product, sort string) (any, string) {
+	switch sort {
+	case "name":
+		return p.Name, p.Id
+	case "price":
+		return p.Price, p.Id
+	case "category_name":
+		return p.CategoryName, p.Id
+	case "seller_name":
+		return p.SellerName, p.Id
+	case "created_at":
+		return p.CreatedAt, p.Id
+	case "updated_at":
+		return p.UpdatedAt, p.Id
+	}
+	return nil, p.Id
+}
+
+// hitKey returns the value of h that sort orders by, and its product's id.
+func hitKey(h *pb.ProductHit, sort string) (any, string) {
+	if sort == "rank" {
+		return float64(h.Rank), h.Product.Id
+	}
+	return productKey(h.Product, sort)
+}
+
+// categoryKey returns the value of c that sort orders by, and its id.
+func categoryKey(c *pb.Category, sort string) (any, string) {
+	switch sort {
+	case "name":
+		return c.Name, c.Id
+	case "created_at":
+		return c.CreatedAt, c.Id
+	case "updated_at":
+		return c.UpdatedAt, c.Id
+	}
+	return nil, c.Id
+}
+
+// dateRange limits column to the days from early to late inclusive, given
+// as YYYY-MM-DD. Either end may be left empty.
+func dateRange(q *sqlbuilder.Query, column, field, early, late string) error {
+	if early != "" {
+		t, err := time.Parse(time.DateOnly, early)
+		if err != nil {
+			return fmt.Errorf("%w: %s_early: %v", sqlbuilder.ErrInvalidFilter, field, err)
+		}
+		q.Where(column+" >= ?", t)
+	}
+	if late != "" {
+		t, err := time.Parse(time.DateOnly, late)
+		if err != nil {
+			return fmt.Errorf("%w: %s_late: %v", sqlbuilder.ErrInvalidFilter, field, err)
+		}
+		q.Where(column+" < ?", t.AddDate(0, 0, 1))
+	}
+	return nil
 }
@@ -0,0 +1,177 @@
+package query
+
+import (
+	"errors"
+	"testing"
+
+	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	"github.com/daffaromero/retries/services/common/sqlbuilder"
+	"github.com/daffaromero/retries/services/common/sqlbuilder/sqltest"
+)
+
+func TestProductFilters(t *testing.T) {
+	tests := []struct {
+		name string
+		mode string
+		req  *pb.GetProductFilter
+	}{
+		{"products_default", "user", &pb.GetProductFilter{}},
+		{"products_all_filters", "user", &pb.GetProductFilter{
+			SellerId:        "seller-1",
+			VisEarly:        "2024-01-01",
+			VisLate:         "2024-01-31",
+			InvisEarly:      "2024-02-01",
+			Visibility:      "public",
+			Exclusion:       "none",
+			IsAdminVerified: "true",
+			LowestPrice:     100,
+			HighestPrice:    500,
+			Categories:      []*pb.Category{{Name: "books"}, {Name: "games"}},
+			Search:          "chess",
+			Pagination:      &pb.Pagination{Page: 3, Limit: 20},
+			Sorting:         &pb.Sorting{OrderBy: "price", IsReversed: true},
+		}},
+		{"products_injection", "user", &pb.GetProductFilter{
+			Visibility: "public' OR '1'='1",
+			Categories: []*pb.Category{{Name: "x'); DROP TABLE products; --"}},
+			Search:     "%' OR 1=1 --",
+		}},
+		{"products_seller_search", "seller", &pb.GetProductFilter{
+			Search:     "100%_cotton",
+			Pagination: &pb.Pagination{Limit: 5, Offset: 15},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := ProductFilters(tt.mode, tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sql, args := q.Build()
+			sqltest.Golden(t, tt.name, sql, args)
+		})
+	}
+}
+
+func TestProductFiltersCursor(t *testing.T) {
+	req := &pb.GetProductFilter{
+		Visibility: "public",
+		Pagination: &pb.Pagination{Limit: 2},
+		Sorting:    &pb.Sorting{OrderBy: "price"},
+	}
+	q, err := ProductFilters("user", req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	products := []*pb.Product{{Id: "p1", Price: 100}, {Id: "p2", Price: 150}, {Id: "p3", Price: 150}}
+	page, next := sqlbuilder.Next(q, products, productKey)
+	if len(page) != 2 || next == "" {
+		t.Fatalf("got %d products and cursor %q, want 2 products and a cursor", len(page), next)
+	}
+
+	req.Pagination = &pb.Pagination{Limit: 2, Page: 5, Cursor: next}
+	q, err = ProductFilters("user", req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sql, args := q.Build()
+	sqltest.Golden(t, "products_after_cursor", sql, args)
+
+	req.Sorting = &pb.Sorting{OrderBy: "name"}
+	if _, err := ProductFilters("user", req); !errors.Is(err, sqlbuilder.ErrInvalidFilter) {
+		t.Errorf("cursor reused with another sort: err = %v, want ErrInvalidFilter", err)
+	}
+}
+
+func TestSearchFilters(t *testing.T) {
+	req := &pb.GetProductFilter{
+		Search:       `"chess set" wood*`,
+		Visibility:   "public",
+		LowestPrice:  100,
+		HighestPrice: 500,
+		Categories:   []*pb.Category{{Name: "games"}},
+		Pagination:   &pb.Pagination{Limit: 2},
+	}
+	q, err := SearchFilters("user", req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sql, args := q.Build()
+	sqltest.Golden(t, "search", sql, args)
+
+	hits := []*pb.ProductHit{
+		{Product: &pb.Product{Id: "p1"}, Rank: 0.5},
+		{Product: &pb.Product{Id: "p2"}, Rank: 0.25},
+		{Product: &pb.Product{Id: "p3"}, Rank: 0.25},
+	}
+	_, next := sqlbuilder.Next(q, hits, hitKey)
+	req.Pagination = &pb.Pagination{Limit: 2, Cursor: next}
+	q, err = SearchFilters("user", req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sql, args = q.Build()
+	sqltest.Golden(t, "search_after_cursor", sql, args)
+
+	q, err = SearchFilters("seller", &pb.GetProductFilter{Search: "chess", Sorting: &pb.Sorting{OrderBy: "price"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sql, args = q.Build()
+	sqltest.Golden(t, "search_seller_by_price", sql, args)
+
+	sql, args, err = CategoryFacet("user", req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqltest.Golden(t, "search_category_facet", sql, args)
+
+	sql, args, err = PriceFacet("user", req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqltest.Golden(t, "search_price_facet", sql, args)
+}
+
+func TestSearchFiltersNeedWords(t *testing.T) {
+	for _, s := range []string{"", "  ", "&|!"} {
+		if _, err := SearchFilters("user", &pb.GetProductFilter{Search: s}); !errors.Is(err, sqlbuilder.ErrInvalidFilter) {
+			t.Errorf("SearchFilters(%q): err = %v, want ErrInvalidFilter", s, err)
+		}
+	}
+}
+
+func TestProductFiltersRejects(t *testing.T) {
+	for name, req := range map[string]*pb.GetProductFilter{
+		"unknown sort": {Sorting: &pb.Sorting{OrderBy: "price; DROP TABLE products"}},
+		"bad date":     {VisEarly: "yesterday"},
+	} {
+		if _, err := ProductFilters("user", req); !errors.Is(err, sqlbuilder.ErrInvalidFilter) {
+			t.Errorf("%s: err = %v, want ErrInvalidFilter", name, err)
+		}
+	}
+}
+
+func TestCategoryFilters(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *pb.GetCategoryFilter
+	}{
+		{"categories_default", &pb.GetCategoryFilter{}},
+		{"categories_search_sorted", &pb.GetCategoryFilter{
+			Search:     "home_",
+			Pagination: &pb.Pagination{Limit: 10, Offset: 10},
+			Sorting:    &pb.Sorting{OrderBy: "name"},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := CategoryFilters(tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sql, args := q.Build()
+			sqltest.Golden(t, tt.name, sql, args)
+		})
+	}
+}
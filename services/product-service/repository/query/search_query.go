@@ -0,0 +1,94 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	"github.com/daffaromero/retries/services/common/sqlbuilder"
+	"github.com/daffaromero/retries/services/product-service/search"
+	"github.com/jackc/pgx/v5"
+)
+
+// SearchProducts runs the page, its total and both facets on tx, so they all
+// count the same rows.
+func (p *ProductQueryImpl) SearchProducts(c context.Context, tx pgx.Tx, req *pb.GetProductFilter) (*pb.SearchProductsResponse, error) {
+	q, err := SearchFilters("user", req)
+	if err != nil {
+		return nil, err
+	}
+	query, args := q.Build()
+	rows, err := tx.Query(c, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var hits []*pb.ProductHit
+	for rows.Next() {
+		hit := &pb.ProductHit{Product: &pb.Product{}}
+		if err := scanProduct(rows, hit.Product, &hit.Rank, &hit.NameHighlight, &hit.Snippet); err != nil {
+			return nil, err
+		}
+		hits = append(hits, hit)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	res := &pb.SearchProductsResponse{}
+	res.Hits, res.NextCursor = sqlbuilder.Next(q, hits, hitKey)
+	if req.Pagination.GetWithTotal() {
+		query, args := q.Count()
+		if err := tx.QueryRow(c, query, args...).Scan(&res.Total); err != nil {
+			return nil, fmt.Errorf("count query error: %w", err)
+		}
+	}
+
+	if res.Categories, err = p.categoryFacet(c, tx, req); err != nil {
+		return nil, err
+	}
+	if res.PriceRanges, err = p.priceFacet(c, tx, req); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (p *ProductQueryImpl) categoryFacet(c context.Context, tx pgx.Tx, req *pb.GetProductFilter) ([]*pb.FacetCount, error) {
+	query, args, err := CategoryFacet("user", req)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := tx.Query(c, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("category facet error: %w", err)
+	}
+	defer rows.Close()
+
+	var counts []*pb.FacetCount
+	for rows.Next() {
+		var count pb.FacetCount
+		if err := rows.Scan(&count.Value, &count.Count); err != nil {
+			return nil, fmt.Errorf("category facet error: %w", err)
+		}
+		counts = append(counts, &count)
+	}
+	return counts, rows.Err()
+}
+
+func (p *ProductQueryImpl) priceFacet(c context.Context, tx pgx.Tx, req *pb.GetProductFilter) ([]*pb.PriceRangeCount, error) {
+	query, args, err := PriceFacet("user", req)
+	if err != nil {
+		return nil, err
+	}
+	counts := make([]*pb.PriceRangeCount, len(search.PriceRanges))
+	dest := make([]any, len(search.PriceRanges))
+	for i, r := range search.PriceRanges {
+		counts[i] = &pb.PriceRangeCount{Min: r.Min, Max: r.Max}
+		dest[i] = &counts[i].Count
+	}
+	if err := tx.QueryRow(c, query, args...).Scan(dest...); err != nil {
+		return nil, fmt.Errorf("price facet error: %w", err)
+	}
+	return counts, nil
+}
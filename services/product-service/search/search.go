@@ -0,0 +1,107 @@
+// Package search turns what a user types into a Postgres tsquery over the
+// products.search_vector column, and defines the facets search results are
+// counted by.
+//
+// search_vector weighs name as A, category_name and seller_name as B and
+// description as C, all under the "english" text search configuration.
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Config is the text search configuration search_vector is built with.
+// Queries must be parsed under the same one.
+const Config = "english"
+
+// Weights restricts a query to some of the weighted fields of
+// search_vector, such as "A" for names only. Empty matches every field.
+type Weights string
+
+const (
+	AllFields Weights = ""
+	NameOnly  Weights = "A"
+)
+
+// Parse turns input into to_tsquery syntax. Words are ANDed together; a
+// word ending in * matches as a prefix, and words in double quotes match as
+// a phrase. Everything but letters and digits is dropped from words, so the
+// result is always valid to_tsquery input. Parse returns "" when input has
+// no words.
+func Parse(input string, weights Weights) string {
+	var terms []string
+
+	for i, part := range strings.Split(input, `"`) {
+		if i%2 == 1 {
+			// Inside quotes. An unclosed quote runs to the end of input.
+			var words []string
+			for _, field := range strings.Fields(part) {
+				if w := clean(field); w != "" {
+					words = append(words, w+label(weights, false))
+				}
+			}
+			switch len(words) {
+			case 0:
+			case 1:
+				terms = append(terms, words[0])
+			default:
+				terms = append(terms, "("+strings.Join(words, " <-> ")+")")
+			}
+			continue
+		}
+		for _, field := range strings.Fields(part) {
+			prefix := strings.HasSuffix(field, "*")
+			if w := clean(field); w != "" {
+				terms = append(terms, w+label(weights, prefix))
+			}
+		}
+	}
+	return strings.Join(terms, " & ")
+}
+
+func clean(word string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, word)
+}
+
+func label(weights Weights, prefix bool) string {
+	switch {
+	case prefix:
+		return ":*" + string(weights)
+	case weights != "":
+		return ":" + string(weights)
+	}
+	return ""
+}
+
+// PriceRange is a bucket of the price facet, from Min up to but not
+// including Max. A zero Max has no upper bound.
+type PriceRange struct {
+	Min int32
+	Max int32
+}
+
+// PriceRanges are the buckets search results are counted in by price.
+var PriceRanges = []PriceRange{
+	{0, 50000},
+	{50000, 100000},
+	{100000, 250000},
+	{250000, 500000},
+	{500000, 1000000},
+	{1000000, 0},
+}
+
+// CategoryFacetLimit is how many categories the category facet lists, most
+// matches first.
+const CategoryFacetLimit = 20
+
+// Highlight options for ts_headline: matches are wrapped in <mark> tags.
+const (
+	NameHighlight      = "StartSel=<mark>, StopSel=</mark>, HighlightAll=true"
+	DescriptionSnippet = "StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=25, MinWords=10, FragmentDelimiter=\" … \""
+)
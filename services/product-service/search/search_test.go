@@ -0,0 +1,29 @@
+package search
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input   string
+		weights Weights
+		want    string
+	}{
+		{"", AllFields, ""},
+		{"   ", AllFields, ""},
+		{"chess", AllFields, "chess"},
+		{"Chess Board", AllFields, "chess & board"},
+		{"boa*", AllFields, "boa:*"},
+		{`"red queen" gam*`, AllFields, "(red <-> queen) & gam:*"},
+		{`"queen"`, AllFields, "queen"},
+		{`"red queen`, AllFields, "(red <-> queen)"},
+		{"chess board*", NameOnly, "chess:A & board:*A"},
+		{"it's 100% & | ! <-> :*", AllFields, "its & 100"},
+		{"'); DROP TABLE products; --", AllFields, "drop & table & products"},
+		{"café", AllFields, "café"},
+	}
+	for _, tt := range tests {
+		if got := Parse(tt.input, tt.weights); got != tt.want {
+			t.Errorf("Parse(%q, %q) = %q, want %q", tt.input, tt.weights, got, tt.want)
+		}
+	}
+}
@@ -2,13 +2,15 @@ package service
 
 import (
 	"context"
-	"strings"
+	"errors"
 
 	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	"github.com/daffaromero/retries/services/common/sqlbuilder"
 	"github.com/daffaromero/retries/services/common/utils/logger"
 	"github.com/daffaromero/retries/services/product-service/repository"
 	"github.com/gofiber/fiber/v3"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -41,30 +43,30 @@ func (c *CategoryServiceImpl) CreateCategory(ctx context.Context, cat *pb.Catego
 	cat.UpdatedAt = now
 
 	res, err := c.catRepo.CreateCategory(ctx, cat)
-	if err != nil {
-		c.logger.CustomError("Category creation failed", err)
-		if strings.Contains(err.Error(), "duplicate key value") {
-			return nil, fiber.NewError(fiber.StatusBadRequest, "Category already exists.")
-		}
-		return nil, err
+	if duplicate(err) {
+		return nil, fiber.NewError(fiber.StatusConflict, "Category already exists.")
+	} else if err != nil {
+		return nil, internalError(c.logger, "failed to create category", err)
 	}
 	return res, nil
 }
 
 func (c *CategoryServiceImpl) GetCategoryByID(ctx context.Context, fil *pb.GetCategoryFilter) (*pb.GetCategoryResponse, error) {
 	res, err := c.catRepo.GetCategoryByID(ctx, fil)
-	if err != nil {
-		c.logger.CustomError("Failed to get category by ID", err)
-		return nil, err
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fiber.NewError(fiber.StatusNotFound, "category not found")
+	} else if err != nil {
+		return nil, internalError(c.logger, "failed to get category", err)
 	}
 	return res, nil
 }
 
 func (c *CategoryServiceImpl) GetCategories(ctx context.Context, fil *pb.GetCategoryFilter) (*pb.GetCategoryResponse, error) {
 	categories, err := c.catRepo.GetCategories(ctx, fil)
-	if err != nil {
-		c.logger.CustomError("Failed to get categories", err)
-		return nil, err
+	if errors.Is(err, sqlbuilder.ErrInvalidFilter) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if err != nil {
+		return nil, internalError(c.logger, "failed to get categories", err)
 	}
 	return categories, nil
 }
@@ -75,9 +77,10 @@ func (c *CategoryServiceImpl) UpdateCategory(ctx context.Context, cat *pb.Catego
 	cat.UpdatedAt = timestamppb.Now()
 
 	res, err := c.catRepo.UpdateCategory(ctx, cat)
-	if err != nil {
-		c.logger.CustomError("Failed to update category", err)
-		return nil, err
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fiber.NewError(fiber.StatusNotFound, "category not found")
+	} else if err != nil {
+		return nil, internalError(c.logger, "failed to update category", err)
 	}
 	return res, nil
 }
@@ -85,8 +88,7 @@ func (c *CategoryServiceImpl) UpdateCategory(ctx context.Context, cat *pb.Catego
 func (c *CategoryServiceImpl) DeleteCategory(ctx context.Context, filter *pb.GetCategoryFilter) (*pb.DeleteCategoryResponse, error) {
 	res, err := c.catRepo.DeleteCategory(ctx, filter)
 	if err != nil {
-		c.logger.CustomError("Failed to delete category", err)
-		return nil, err
+		return nil, internalError(c.logger, "failed to delete category", err)
 	}
 	return res, nil
 }
@@ -2,12 +2,16 @@ package service
 
 import (
 	"context"
+	"errors"
 
 	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	"github.com/daffaromero/retries/services/common/sqlbuilder"
 	"github.com/daffaromero/retries/services/common/utils/logger"
 	"github.com/daffaromero/retries/services/product-service/repository"
 	"github.com/gofiber/fiber/v3"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -15,6 +19,7 @@ type ProductService interface {
 	CreateProduct(context.Context, *pb.Product, string, string) (*pb.Product, error)
 	GetProductByID(context.Context, *pb.GetProductFilter) (*pb.GetProductResponse, error)
 	GetAllProducts(context.Context, *pb.GetProductFilter) (*pb.GetProductResponse, error)
+	SearchProducts(context.Context, *pb.GetProductFilter) (*pb.SearchProductsResponse, error)
 	UpdateProduct(context.Context, *pb.Product) (*pb.Product, error)
 	ApproveProduct(context.Context, *pb.ApproveProductRequest) (*pb.ApproveProductResponse, error)
 }
@@ -45,30 +50,49 @@ func (p *productService) CreateProduct(c context.Context, product *pb.Product, s
 	product.UpdatedAt = timestamppb.Now()
 
 	res, err := p.productRepo.CreateProduct(c, product)
-	if err != nil {
-		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	if duplicate(err) {
+		return nil, fiber.NewError(fiber.StatusConflict, "product already exists")
+	} else if err != nil {
+		return nil, internalError(p.logger, "failed to create product", err)
 	}
 	return res, nil
 }
 
 func (p *productService) GetProductByID(c context.Context, filter *pb.GetProductFilter) (*pb.GetProductResponse, error) {
 	res, err := p.productRepo.GetProductByID(c, filter)
-	if err != nil {
-		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fiber.NewError(fiber.StatusNotFound, "product not found")
+	} else if err != nil {
+		return nil, internalError(p.logger, "failed to get product", err)
 	}
 	return res, nil
 }
 
 func (p *productService) GetAllProducts(c context.Context, filter *pb.GetProductFilter) (*pb.GetProductResponse, error) {
 	res, err := p.productRepo.GetAllProducts(c, filter)
-	if err != nil {
-		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+	if errors.Is(err, sqlbuilder.ErrInvalidFilter) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if err != nil {
+		return nil, internalError(p.logger, "failed to get products", err)
+	}
+	return res, nil
+}
+
+func (p *productService) SearchProducts(c context.Context, filter *pb.GetProductFilter) (*pb.SearchProductsResponse, error) {
+	res, err := p.productRepo.SearchProducts(c, filter)
+	if errors.Is(err, sqlbuilder.ErrInvalidFilter) {
+		return nil, fiber.NewError(fiber.StatusBadRequest, err.Error())
+	} else if err != nil {
+		return nil, internalError(p.logger, "failed to search products", err)
 	}
 	return res, nil
 }
 
 func (p *productService) UpdateProduct(c context.Context, product *pb.Product) (*pb.Product, error) {
-	pro, _ := p.productRepo.GetProductByID(c, &pb.GetProductFilter{Id: product.Id})
+	pro, err := p.GetProductByID(c, &pb.GetProductFilter{Id: product.Id})
+	if err != nil {
+		return nil, err
+	}
 	if pro.Products[0].Visibility == "active" {
 		return nil, fiber.NewError(fiber.StatusForbidden, "product is already active")
 	}
@@ -82,7 +106,7 @@ func (p *productService) UpdateProduct(c context.Context, product *pb.Product) (
 
 	res, err := p.productRepo.UpdateProduct(c, product)
 	if err != nil {
-		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		return nil, internalError(p.logger, "failed to update product", err)
 	}
 	return res, nil
 }
@@ -90,7 +114,20 @@ func (p *productService) UpdateProduct(c context.Context, product *pb.Product) (
 func (p *productService) ApproveProduct(c context.Context, req *pb.ApproveProductRequest) (*pb.ApproveProductResponse, error) {
 	res, err := p.productRepo.ApproveProduct(c, req)
 	if err != nil {
-		return nil, fiber.NewError(fiber.StatusInternalServerError, err.Error())
+		return nil, internalError(p.logger, "failed to approve product", err)
 	}
 	return res, nil
 }
+
+// internalError logs err and returns a 500 that only says msg, so query and
+// driver details stay out of responses.
+func internalError(log *logger.Log, msg string, err error) error {
+	log.CustomError(msg, err)
+	return fiber.NewError(fiber.StatusInternalServerError, msg)
+}
+
+// duplicate reports whether err is a unique constraint violation.
+func duplicate(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
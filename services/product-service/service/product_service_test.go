@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	pb "github.com/daffaromero/retries/services/common/genproto/grpc-api"
+	"github.com/daffaromero/retries/services/common/utils/logger"
+	"github.com/daffaromero/retries/services/product-service/repository"
+	"github.com/gofiber/fiber/v3"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// fakeProducts fails every call with err.
+type fakeProducts struct {
+	repository.ProductRepository
+	err error
+}
+
+func (f *fakeProducts) CreateProduct(c context.Context, product *pb.Product) (*pb.Product, error) {
+	return nil, f.err
+}
+
+func (f *fakeProducts) GetProductByID(c context.Context, filter *pb.GetProductFilter) (*pb.GetProductResponse, error) {
+	return nil, f.err
+}
+
+func TestProductErrors(t *testing.T) {
+	driverErr := &pgconn.PgError{Code: "42P01", Message: `relation "products" does not exist`}
+	tests := []struct {
+		name     string
+		err      error
+		call     func(ProductService) error
+		wantCode int
+	}{
+		{
+			name: "missing product",
+			err:  pgx.ErrNoRows,
+			call: func(p ProductService) error {
+				_, err := p.GetProductByID(context.Background(), &pb.GetProductFilter{Id: "p-1"})
+				return err
+			},
+			wantCode: fiber.StatusNotFound,
+		},
+		{
+			name: "update of a missing product",
+			err:  pgx.ErrNoRows,
+			call: func(p ProductService) error {
+				_, err := p.UpdateProduct(context.Background(), &pb.Product{Id: "p-1"})
+				return err
+			},
+			wantCode: fiber.StatusNotFound,
+		},
+		{
+			name: "duplicate product",
+			err:  &pgconn.PgError{Code: "23505"},
+			call: func(p ProductService) error {
+				_, err := p.CreateProduct(context.Background(), &pb.Product{}, "s-1", "seller")
+				return err
+			},
+			wantCode: fiber.StatusConflict,
+		},
+		{
+			name: "driver error",
+			err:  driverErr,
+			call: func(p ProductService) error {
+				_, err := p.GetProductByID(context.Background(), &pb.GetProductFilter{Id: "p-1"})
+				return err
+			},
+			wantCode: fiber.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewProductService(&fakeProducts{err: tt.err}, logger.NewLog("test"))
+			err := tt.call(p)
+			var fe *fiber.Error
+			if !errors.As(err, &fe) || fe.Code != tt.wantCode {
+				t.Fatalf("error = %v, want a %d", err, tt.wantCode)
+			}
+			if strings.Contains(fe.Message, "relation") {
+				t.Errorf("message %q leaks the driver error", fe.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package config
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/daffaromero/retries/services/common/utils"
+)
+
+type ServerConfig struct {
+	URI  string
+	Port string
+	Host string
+}
+
+func NewServerConfig() ServerConfig {
+	uri := utils.GetEnv("SERVER_URI")
+	port := utils.GetEnv("SERVER_PORT")
+	if port == "" {
+		log.Fatal("SERVER_PORT environment variable is not set")
+	}
+	return ServerConfig{
+		URI:  uri,
+		Port: port,
+		Host: fmt.Sprintf("%s:%s", uri, port),
+	}
+}
@@ -0,0 +1,29 @@
+// Command registry-service shares one in-memory service registry between
+// the services over HTTP, for running the stack without Consul. Start it and
+// run the other services with DISCOVERY_BACKEND=remote and REGISTRY_ADDR
+// pointing at it.
+package main
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/daffaromero/retries/services/common/discovery/memory"
+	"github.com/daffaromero/retries/services/common/discovery/remote"
+	"github.com/daffaromero/retries/services/registry-service/config"
+)
+
+func main() {
+	serverConfig := config.NewServerConfig()
+
+	registry := memory.NewRegistry()
+	defer registry.Close()
+
+	mux := http.NewServeMux()
+	remote.NewServer(registry).Route(mux)
+
+	log.Printf("registry listening on %s", serverConfig.Host)
+	if err := http.ListenAndServe(serverConfig.Host, mux); err != nil {
+		log.Fatalf("registry failed: %v", err)
+	}
+}